@@ -3,6 +3,8 @@ package cases
 import (
 	"strings"
 	"unicode"
+
+	"github.com/sitnikovik/stringo/internal/grapheme"
 )
 
 const (
@@ -58,30 +60,21 @@ func DefineStringCase(s string) StringCase {
 	return NormalCase
 }
 
-// ToUpperFirst converts the first character of a string to uppercase
+// ToUpperFirst converts the first grapheme cluster of a string to uppercase
 func ToUpperFirst(s string) string {
 	return caseFirstFunc(s, unicode.ToUpper)
 }
 
-// ToLowerFirst converts the first character of a string to lowercase
+// ToLowerFirst converts the first grapheme cluster of a string to lowercase
 func ToLowerFirst(s string) string {
 	return caseFirstFunc(s, unicode.ToLower)
 }
 
-// caseFirstFunc converts the first character of a string using the provided function
+// caseFirstFunc converts the first grapheme cluster of a string using the provided function
 func caseFirstFunc(s string, f func(rune) rune) string {
-	if len(s) == 0 {
-		return ""
-	}
-
-	runes := []rune(s)
-	runes[0] = f(runes[0])
+	n := grapheme.FirstLen(s)
 
-	if len(runes) == 1 {
-		return string(runes)
-	}
-
-	return string(runes[0]) + string(runes[1:])
+	return strings.Map(f, s[:n]) + s[n:]
 }
 
 // SplitToWords splits a string into words
@@ -124,6 +117,24 @@ func split(s, sep string, sepcond func(r rune, idx int) bool, f func(r rune, idx
 	return sb.String()
 }
 
+// splitBefore splits a string before every rune matching the condition
+func splitBefore(s string, cond func(r rune, idx int) bool) []string {
+	var ss []string
+
+	start := 0
+	for i, r := range s {
+		if cond(r, i) {
+			ss = append(ss, s[start:i])
+			start = i
+		}
+	}
+	if start < len(s) {
+		ss = append(ss, s[start:])
+	}
+
+	return ss
+}
+
 // join joins strings with separator and apply function to each string
 func join(ss []string, sep string, f func(s string, idx int) string) string {
 	if len(ss) == 0 {
@@ -34,6 +34,30 @@ func TestToUpperFirst(t *testing.T) {
 			},
 			want: "H",
 		},
+		{
+			args: args{
+				s: "e\u0301cole",
+			},
+			want: "E\u0301cole",
+		},
+		{
+			args: args{
+				s: "👍🏽 ok",
+			},
+			want: "👍🏽 ok",
+		},
+		{
+			args: args{
+				s: "a\xffb",
+			},
+			want: "A\xffb",
+		},
+		{
+			args: args{
+				s: "",
+			},
+			want: "",
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -75,6 +99,18 @@ func TestToLowerFirst(t *testing.T) {
 			},
 			want: "h",
 		},
+		{
+			args: args{
+				s: "E\u0301cole",
+			},
+			want: "e\u0301cole",
+		},
+		{
+			args: args{
+				s: "🇺🇸 USA",
+			},
+			want: "🇺🇸 USA",
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -4,8 +4,6 @@ import (
 	"regexp"
 	"strings"
 	"unicode"
-
-	"github.com/sitnikovik/stringo"
 )
 
 var pascalCaseRE = regexp.MustCompile("^[A-Z][a-z]+(?:[A-Z][a-z]+)*$")
@@ -94,9 +92,9 @@ func FromPascalToScreamingSnakeCase(s string) string {
 // FromPascalToTrainCase converts a PascalCase string to Train-Case.
 // Keep in mind that it skips spaces cause of these does not match PascalCase.
 func FromPascalToTrainCase(s string) string {
-	ss := stringo.SplitFunc(s, func(r rune, idx int) bool {
+	ss := splitBefore(s, func(r rune, idx int) bool {
 		return idx > 0 && unicode.IsUpper(r)
-	}, true)
+	})
 
 	for i, w := range ss {
 		ss[i] = ToUpperFirst(strings.ToLower(w))
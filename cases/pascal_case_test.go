@@ -325,6 +325,18 @@ func TestFromPascalToTrainCase(t *testing.T) {
 			},
 			want: "String with spaces to be up with first",
 		},
+		{
+			args: args{
+				s: "Ab-cD",
+			},
+			want: "Ab-c-D",
+		},
+		{
+			args: args{
+				s: "Hello-world Foo",
+			},
+			want: "Hello-world -Foo",
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -0,0 +1,17 @@
+package cases
+
+// FuncMap returns the case conversions for text/template and html/template:
+// snake, camel, pascal, kebab, screamingSnake, train, dot, upperFirst and lowerFirst.
+func FuncMap() map[string]any {
+	return map[string]any{
+		"snake":          ToSnakeCase,
+		"camel":          ToCamelCase,
+		"pascal":         ToPascalCase,
+		"kebab":          ToKebabCase,
+		"screamingSnake": ToScreamingSnakeCase,
+		"train":          ToTrainCase,
+		"dot":            ToDotCase,
+		"upperFirst":     ToUpperFirst,
+		"lowerFirst":     ToLowerFirst,
+	}
+}
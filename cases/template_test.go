@@ -0,0 +1,51 @@
+package cases
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFuncMap(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		tmpl string
+		want string
+	}{
+		{tmpl: `{{ snake .S }}`, want: "hello_world"},
+		{tmpl: `{{ .S | camel }}`, want: "helloWorld"},
+		{tmpl: `{{ pascal .S }}`, want: "HelloWorld"},
+		{tmpl: `{{ kebab .S }}`, want: "hello-world"},
+		{tmpl: `{{ screamingSnake .S }}`, want: "HELLO_WORLD"},
+		{tmpl: `{{ train .S }}`, want: "Hello-World"},
+		{tmpl: `{{ dot .S }}`, want: "hello.world"},
+		{tmpl: `{{ upperFirst .S }}`, want: "Hello world"},
+		{tmpl: `{{ lowerFirst "Hello" }}`, want: "hello"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.tmpl, func(t *testing.T) {
+			t.Parallel()
+
+			tmpl := template.Must(template.New("").Funcs(FuncMap()).Parse(tt.tmpl))
+			sb := strings.Builder{}
+
+			require.NoError(t, tmpl.Execute(&sb, map[string]string{"S": "hello world"}))
+			require.Equal(t, tt.want, sb.String())
+		})
+	}
+}
+
+func TestFuncMap_HTML(t *testing.T) {
+	t.Parallel()
+
+	tmpl := htmltemplate.Must(htmltemplate.New("").Funcs(FuncMap()).Parse(`<a id="{{ kebab .S }}">`))
+	sb := strings.Builder{}
+
+	require.NoError(t, tmpl.Execute(&sb, map[string]string{"S": "Hello World"}))
+	require.Equal(t, `<a id="hello-world">`, sb.String())
+}
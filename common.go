@@ -1,7 +1,11 @@
 package stringo
 
 import (
+	"errors"
+	"fmt"
+	"iter"
 	"strings"
+	"unicode/utf8"
 )
 
 // Splitunc splits string by condition
@@ -30,3 +34,159 @@ func SplitFunc(s string, cond func(rune, int) bool, incSep bool) []string {
 
 	return ss
 }
+
+// SplitSeq returns an iterator over the parts of s split by condition.
+// It yields the same parts as SplitFunc, but as substrings of s without
+// copying them, so invalid UTF-8 is kept as is.
+func SplitSeq(s string, cond func(rune, int) bool, incSep bool) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for _, part := range SplitIndexSeq(s, cond, incSep) {
+			if !yield(part) {
+				return
+			}
+		}
+	}
+}
+
+// SplitIndexSeq is like SplitSeq but also yields the byte offset of every part in s.
+func SplitIndexSeq(s string, cond func(rune, int) bool, incSep bool) iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		if cond == nil {
+			yield(0, s)
+			return
+		}
+
+		start := 0
+		for i, r := range s {
+			if !cond(r, i) {
+				continue
+			}
+			if !yield(start, s[start:i]) {
+				return
+			}
+			start = i
+			if !incSep {
+				_, size := utf8.DecodeRuneInString(s[i:])
+				start += size
+			}
+		}
+		if start < len(s) {
+			yield(start, s[start:])
+		}
+	}
+}
+
+// ErrUnbalanced is returned by SplitQuoted for unterminated quotes,
+// unmatched brackets and dangling escape characters.
+var ErrUnbalanced = errors.New("stringo: unbalanced input")
+
+// QuoteOptions describes the quotes, brackets and escapes respected by SplitQuoted.
+// The zero value respects nothing, so SplitQuoted splits at every separator.
+type QuoteOptions struct {
+	// Quotes maps opening quotes to closing ones.
+	// Separators, brackets and other quotes are not special inside quotes.
+	Quotes map[rune]rune
+	// Brackets maps opening brackets to closing ones. Brackets nest
+	// and separators inside them do not split.
+	Brackets map[rune]rune
+	// Escape makes the next rune literal, also inside quotes. Zero means no escapes.
+	Escape rune
+	// Unquote removes quotes and escape characters from the parts.
+	Unquote bool
+}
+
+// DefaultQuoteOptions returns options respecting double quotes, single quotes
+// and backticks, round, square and curly brackets and backslash escapes.
+func DefaultQuoteOptions() QuoteOptions {
+	return QuoteOptions{
+		Quotes:   map[rune]rune{'"': '"', '\'': '\'', '`': '`'},
+		Brackets: map[rune]rune{'(': ')', '[': ']', '{': '}'},
+		Escape:   '\\',
+	}
+}
+
+// SplitQuoted splits string by the separator outside of quotes and brackets.
+// Like strings.Split it returns empty parts for adjacent separators.
+// The error wraps ErrUnbalanced and tells the position of the offending rune.
+func SplitQuoted(s string, sep rune, opts QuoteOptions) ([]string, error) {
+	closing := make(map[rune]bool, len(opts.Brackets))
+	for _, c := range opts.Brackets {
+		closing[c] = true
+	}
+
+	var (
+		parts    []string
+		sb       strings.Builder
+		start    int
+		brackets []int // positions of the open brackets
+		quote    rune  // closing rune of the open quote
+		quotePos int
+		escaped  bool
+		escPos   int
+	)
+	// keep adds the rune to the current part when unquoting.
+	keep := func(r rune) {
+		if opts.Unquote {
+			sb.WriteRune(r)
+		}
+	}
+	for i, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+			keep(r)
+		case opts.Escape != 0 && r == opts.Escape:
+			escaped, escPos = true, i
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				keep(r)
+			}
+		case opts.Quotes[r] != 0:
+			quote, quotePos = opts.Quotes[r], i
+		case opts.Brackets[r] != 0:
+			brackets = append(brackets, i)
+			keep(r)
+		case closing[r]:
+			if len(brackets) == 0 {
+				return nil, fmt.Errorf("%w: unexpected %q at %d", ErrUnbalanced, r, i)
+			}
+			open, _ := utf8.DecodeRuneInString(s[brackets[len(brackets)-1]:])
+			if opts.Brackets[open] != r {
+				return nil, fmt.Errorf("%w: %q at %d closes %q at %d", ErrUnbalanced, r, i, open, brackets[len(brackets)-1])
+			}
+			brackets = brackets[:len(brackets)-1]
+			keep(r)
+		case r == sep && len(brackets) == 0:
+			parts = append(parts, quotedPart(s[start:i], &sb, opts.Unquote))
+			_, size := utf8.DecodeRuneInString(s[i:])
+			start = i + size
+		default:
+			keep(r)
+		}
+	}
+
+	switch {
+	case escaped:
+		return nil, fmt.Errorf("%w: dangling escape at %d", ErrUnbalanced, escPos)
+	case quote != 0:
+		return nil, fmt.Errorf("%w: unterminated quote at %d", ErrUnbalanced, quotePos)
+	case len(brackets) > 0:
+		pos := brackets[len(brackets)-1]
+		open, _ := utf8.DecodeRuneInString(s[pos:])
+		return nil, fmt.Errorf("%w: unclosed %q at %d", ErrUnbalanced, open, pos)
+	}
+
+	return append(parts, quotedPart(s[start:], &sb, opts.Unquote)), nil
+}
+
+// quotedPart returns the raw part or the unquoted one collected in sb.
+func quotedPart(raw string, sb *strings.Builder, unquote bool) string {
+	if !unquote {
+		return raw
+	}
+	part := sb.String()
+	sb.Reset()
+	return part
+}
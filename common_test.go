@@ -1,6 +1,8 @@
 package stringo
 
 import (
+	"slices"
+	"strings"
 	"testing"
 	"unicode"
 
@@ -75,3 +77,199 @@ func TestSplitFunc(t *testing.T) {
 		})
 	}
 }
+
+func TestSplitSeq(t *testing.T) {
+	t.Parallel()
+
+	isSpace := func(r rune, i int) bool { return unicode.IsSpace(r) }
+	isUpperOrSpace := func(r rune, i int) bool { return unicode.IsUpper(r) || unicode.IsSpace(r) }
+	type args struct {
+		s      string
+		cond   func(rune, int) bool
+		incSep bool
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{name: "nil func provided", args: args{s: "hello world"}},
+		{name: "empty string", args: args{s: "", cond: isSpace}},
+		{name: "split by space", args: args{s: "hello world", cond: isSpace}},
+		{name: "consecutive separators", args: args{s: " a  b ", cond: isSpace}},
+		{name: "split camelCase", args: args{s: "helloWorldItWorks", cond: isUpperOrSpace, incSep: true}},
+		{name: "split with spaces", args: args{s: "helloWorld itWorks ", cond: isUpperOrSpace, incSep: true}},
+		{name: "multibyte", args: args{s: "привет мир", cond: isSpace}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			want := SplitFunc(tt.args.s, tt.args.cond, tt.args.incSep)
+			got := slices.Collect(SplitSeq(tt.args.s, tt.args.cond, tt.args.incSep))
+			if len(want) == 0 {
+				require.Empty(t, got)
+			} else {
+				require.Equal(t, want, got)
+			}
+
+			for offset, part := range SplitIndexSeq(tt.args.s, tt.args.cond, tt.args.incSep) {
+				require.Equal(t, part, tt.args.s[offset:offset+len(part)])
+			}
+		})
+	}
+}
+
+func TestSplitSeq_Break(t *testing.T) {
+	t.Parallel()
+
+	var got []string
+	for part := range SplitSeq("a b c d", func(r rune, _ int) bool { return r == ' ' }, false) {
+		if part == "c" {
+			break
+		}
+		got = append(got, part)
+	}
+
+	require.Equal(t, []string{"a", "b"}, got)
+}
+
+func TestSplitIndexSeq(t *testing.T) {
+	t.Parallel()
+
+	var offsets []int
+	var parts []string
+	for offset, part := range SplitIndexSeq("ab cd  é", func(r rune, _ int) bool { return r == ' ' }, false) {
+		offsets = append(offsets, offset)
+		parts = append(parts, part)
+	}
+
+	require.Equal(t, []int{0, 3, 6, 7}, offsets)
+	require.Equal(t, []string{"ab", "cd", "", "é"}, parts)
+}
+
+var benchmarkInput = strings.Repeat("helloWorld itWorks fineAnd fast ", 1<<15)
+
+func BenchmarkSplitFunc(b *testing.B) {
+	cond := func(r rune, _ int) bool { return unicode.IsSpace(r) }
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, part := range SplitFunc(benchmarkInput, cond, false) {
+			_ = part
+		}
+	}
+}
+
+func BenchmarkSplitSeq(b *testing.B) {
+	cond := func(r rune, _ int) bool { return unicode.IsSpace(r) }
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for part := range SplitSeq(benchmarkInput, cond, false) {
+			_ = part
+		}
+	}
+}
+
+func TestSplitQuoted(t *testing.T) {
+	t.Parallel()
+
+	unquote := DefaultQuoteOptions()
+	unquote.Unquote = true
+	type args struct {
+		s    string
+		sep  rune
+		opts QuoteOptions
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "zero options split at every separator",
+			args: args{s: `a,"b,c"`, sep: ','},
+			want: []string{"a", `"b`, `c"`},
+		},
+		{
+			name: "quotes",
+			args: args{s: `a,"b,c",'d,e'`, sep: ',', opts: DefaultQuoteOptions()},
+			want: []string{"a", `"b,c"`, `'d,e'`},
+		},
+		{
+			name: "nested brackets",
+			args: args{s: "f(a, g(b, c)), [1, {2, 3}], x", sep: ',', opts: DefaultQuoteOptions()},
+			want: []string{"f(a, g(b, c))", " [1, {2, 3}]", " x"},
+		},
+		{
+			name: "brackets inside quotes are literal",
+			args: args{s: `"(",x`, sep: ',', opts: DefaultQuoteOptions()},
+			want: []string{`"("`, "x"},
+		},
+		{
+			name: "escaped separator",
+			args: args{s: `a\,b,c`, sep: ',', opts: DefaultQuoteOptions()},
+			want: []string{`a\,b`, "c"},
+		},
+		{
+			name: "unquote",
+			args: args{s: `cmd "hello world" 'it''s' a\ b (x y)`, sep: ' ', opts: unquote},
+			want: []string{"cmd", "hello world", "its", "a b", "(x y)"},
+		},
+		{
+			name: "unquote escaped quote",
+			args: args{s: `"say \"hi\""`, sep: ' ', opts: unquote},
+			want: []string{`say "hi"`},
+		},
+		{
+			name: "different closing quote",
+			args: args{s: "«a; b»; c", sep: ';', opts: QuoteOptions{Quotes: map[rune]rune{'«': '»'}}},
+			want: []string{"«a; b»", " c"},
+		},
+		{
+			name: "empty parts",
+			args: args{s: ",a,,", sep: ',', opts: DefaultQuoteOptions()},
+			want: []string{"", "a", "", ""},
+		},
+		{
+			name:    "unterminated quote",
+			args:    args{s: `a,"b,c`, sep: ',', opts: DefaultQuoteOptions()},
+			wantErr: "stringo: unbalanced input: unterminated quote at 2",
+		},
+		{
+			name:    "unclosed bracket",
+			args:    args{s: "f(a, g(b)", sep: ',', opts: DefaultQuoteOptions()},
+			wantErr: "stringo: unbalanced input: unclosed '(' at 1",
+		},
+		{
+			name:    "unexpected closing bracket",
+			args:    args{s: "a), b", sep: ',', opts: DefaultQuoteOptions()},
+			wantErr: "stringo: unbalanced input: unexpected ')' at 1",
+		},
+		{
+			name:    "mismatched brackets",
+			args:    args{s: "(a]", sep: ',', opts: DefaultQuoteOptions()},
+			wantErr: "stringo: unbalanced input: ']' at 2 closes '(' at 0",
+		},
+		{
+			name:    "dangling escape",
+			args:    args{s: `a,b\`, sep: ',', opts: DefaultQuoteOptions()},
+			wantErr: "stringo: unbalanced input: dangling escape at 3",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := SplitQuoted(tt.args.s, tt.args.sep, tt.args.opts)
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, ErrUnbalanced)
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
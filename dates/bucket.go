@@ -0,0 +1,81 @@
+package dates
+
+import "time"
+
+// BucketDef defines a group of a timeline.
+type BucketDef struct {
+	// Label is the header of the group.
+	Label string
+	// Within is the maximum age of the items in the group, exclusive.
+	// Zero accepts items of any age.
+	Within time.Duration
+	// Match overrides Within when set and reports if the item
+	// time t belongs to the group.
+	Match func(t, now time.Time) bool
+}
+
+// Bucket is a group of items under a header.
+type Bucket[T any] struct {
+	// Label is the header of the group.
+	Label string
+	// Items are the items of the group in their original order.
+	Items []T
+}
+
+// DefaultBuckets returns the "Today", "Yesterday", "This week" and "Earlier" groups
+// labeled in the locale, English if nil. Today and yesterday use the same cutoffs as
+// Dynamic and items from the future fall into today. This week is the calendar week
+// starting on Monday in the location of now.
+func DefaultBuckets(locale *Locale) []BucketDef {
+	loc := locale.orDefault()
+	return []BucketDef{
+		{Label: loc.Today, Within: time.Hour * 24},
+		{Label: loc.Yesterday, Within: time.Hour * 24 * 2},
+		{Label: loc.ThisWeek, Match: inThisWeek},
+		{Label: loc.Earlier},
+	}
+}
+
+// GroupByRelativeBucket groups items into the DefaultBuckets in English.
+// Empty groups are omitted.
+func GroupByRelativeBucket[T any](items []T, timeOf func(T) time.Time, now time.Time) []Bucket[T] {
+	return GroupByBuckets(items, timeOf, now, DefaultBuckets(nil))
+}
+
+// GroupByBuckets puts every item into the first matching group of defs.
+// Groups keep the order of defs, empty groups are omitted and items
+// matching no group are dropped.
+func GroupByBuckets[T any](items []T, timeOf func(T) time.Time, now time.Time, defs []BucketDef) []Bucket[T] {
+	groups := make([][]T, len(defs))
+	for _, item := range items {
+		t := timeOf(item)
+		for i, def := range defs {
+			if def.matches(t, now) {
+				groups[i] = append(groups[i], item)
+				break
+			}
+		}
+	}
+
+	buckets := make([]Bucket[T], 0, len(defs))
+	for i, def := range defs {
+		if len(groups[i]) > 0 {
+			buckets = append(buckets, Bucket[T]{Label: def.Label, Items: groups[i]})
+		}
+	}
+	return buckets
+}
+
+// matches reports if the item time t belongs to the group.
+func (d BucketDef) matches(t, now time.Time) bool {
+	if d.Match != nil {
+		return d.Match(t, now)
+	}
+	return d.Within == 0 || now.Sub(t) < d.Within
+}
+
+// inThisWeek reports if t is not before the Monday of the week of now.
+func inThisWeek(t, now time.Time) bool {
+	monday := startOfDay(now).AddDate(0, 0, -(int(now.Weekday())+6)%7)
+	return !t.Before(monday)
+}
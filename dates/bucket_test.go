@@ -0,0 +1,80 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupByRelativeBucket(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 4, 15, 0, 0, 0, time.UTC)
+	items := []time.Duration{
+		-time.Minute,
+		time.Minute,
+		23 * time.Hour,
+		30 * time.Hour,
+		50 * time.Hour,
+		5 * 24 * time.Hour,
+		40 * 24 * time.Hour,
+		2 * time.Hour,
+	}
+	timeOf := func(d time.Duration) time.Time { return now.Add(-d) }
+
+	got := GroupByRelativeBucket(items, timeOf, now)
+
+	require.Equal(t, []Bucket[time.Duration]{
+		{Label: "Today", Items: []time.Duration{-time.Minute, time.Minute, 23 * time.Hour, 2 * time.Hour}},
+		{Label: "Yesterday", Items: []time.Duration{30 * time.Hour}},
+		{Label: "This week", Items: []time.Duration{50 * time.Hour}},
+		{Label: "Earlier", Items: []time.Duration{5 * 24 * time.Hour, 40 * 24 * time.Hour}},
+	}, got)
+}
+
+func TestGroupByBuckets(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 4, 15, 0, 0, 0, time.UTC)
+	items := []time.Time{
+		now.Add(-time.Hour),
+		now.Add(-20 * time.Hour),
+		now.AddDate(0, -2, 0),
+	}
+	id := func(t time.Time) time.Time { return t }
+
+	t.Run("calendar days and empty groups", func(t *testing.T) {
+		t.Parallel()
+
+		defs := []BucketDef{
+			{Label: "Сегодня", Match: func(t, now time.Time) bool { return dateOf(t) == dateOf(now) }},
+			{Label: "Вчера", Match: func(t, now time.Time) bool { return dateOf(t) == dateOf(now.AddDate(0, 0, -1)) }},
+			{Label: "На этой неделе", Within: Week.Duration()},
+		}
+
+		got := GroupByBuckets(items, id, now, defs)
+
+		require.Equal(t, []Bucket[time.Time]{
+			{Label: "Сегодня", Items: items[:1]},
+			{Label: "Вчера", Items: items[1:2]},
+		}, got)
+	})
+
+	t.Run("localized defaults", func(t *testing.T) {
+		t.Parallel()
+
+		got := GroupByBuckets(items, id, now, DefaultBuckets(Russian))
+
+		require.Equal(t, []Bucket[time.Time]{
+			{Label: "Сегодня", Items: items[:2]},
+			{Label: "Ранее", Items: items[2:]},
+		}, got)
+	})
+
+	t.Run("no items", func(t *testing.T) {
+		t.Parallel()
+
+		require.Empty(t, GroupByRelativeBucket(nil, id, now))
+	})
+}
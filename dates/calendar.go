@@ -0,0 +1,376 @@
+package dates
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Calendar describes business days and working hours.
+// The zero value has no weekends, no holidays and works around the clock.
+type Calendar struct {
+	// Weekend lists the days off of every week.
+	Weekend []time.Weekday
+	// WorkStart is the start of working hours as an offset from midnight.
+	WorkStart time.Duration
+	// WorkEnd is the end of working hours as an offset from midnight.
+	// Zero means the end of the day.
+	WorkEnd time.Duration
+	// Location is the location the calendar days are counted in.
+	// The location of the given times is used if nil.
+	Location *time.Location
+	// Locale provides the words of Dynamic, English by default.
+	Locale *Locale
+
+	holidays map[civilDate]string
+}
+
+// civilDate is a calendar day without a location.
+type civilDate struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+// NewCalendar returns a calendar with Saturday and Sunday off
+// and working hours from 9:00 to 18:00.
+func NewCalendar() *Calendar {
+	return &Calendar{
+		Weekend:   []time.Weekday{time.Saturday, time.Sunday},
+		WorkStart: 9 * time.Hour,
+		WorkEnd:   18 * time.Hour,
+	}
+}
+
+// AddHoliday marks the day of t in the calendar location as a holiday with the given name.
+func (c *Calendar) AddHoliday(t time.Time, name string) {
+	if c.holidays == nil {
+		c.holidays = make(map[civilDate]string)
+	}
+	c.holidays[dateOf(c.in(t))] = name
+}
+
+// Holiday returns the name of the holiday on the day of t if it is one.
+func (c *Calendar) Holiday(t time.Time) (string, bool) {
+	name, ok := c.holidays[dateOf(c.in(t))]
+	return name, ok
+}
+
+// IsBusinessDay defines if the day of t is neither a weekend nor a holiday.
+func (c *Calendar) IsBusinessDay(t time.Time) bool {
+	t = c.in(t)
+	for _, wd := range c.Weekend {
+		if t.Weekday() == wd {
+			return false
+		}
+	}
+	_, holiday := c.holidays[dateOf(t)]
+	return !holiday
+}
+
+// AddBusinessDays moves t by n business days keeping the time of day.
+// Negative n moves it backwards. The time is returned as is if every day
+// of the week is a weekend.
+func (c *Calendar) AddBusinessDays(t time.Time, n int) time.Time {
+	t = c.in(t)
+	if !c.hasWorkdays() {
+		return t
+	}
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		t = t.AddDate(0, 0, step)
+		if c.IsBusinessDay(t) {
+			n--
+		}
+	}
+	return t
+}
+
+// BusinessDaysBetween returns the number of business days after the day of a
+// up to and including the day of b. It is negative if b comes before a.
+func (c *Calendar) BusinessDaysBetween(a, b time.Time) int {
+	if b.Before(a) {
+		return -c.BusinessDaysBetween(b, a)
+	}
+	a, b = c.in(a), c.in(b)
+
+	n := 0
+	for d := startOfDay(a).AddDate(0, 0, 1); !d.After(b); d = d.AddDate(0, 0, 1) {
+		if c.IsBusinessDay(d) {
+			n++
+		}
+	}
+	return n
+}
+
+// BusinessTimeBetween returns the working time between a and b.
+// It is negative if b comes before a.
+func (c *Calendar) BusinessTimeBetween(a, b time.Time) time.Duration {
+	if b.Before(a) {
+		return -c.BusinessTimeBetween(b, a)
+	}
+	a, b = c.in(a), c.in(b)
+
+	var total time.Duration
+	for day := startOfDay(a); day.Before(b); day = day.AddDate(0, 0, 1) {
+		if !c.IsBusinessDay(day) {
+			continue
+		}
+		end := c.WorkEnd
+		if end == 0 {
+			end = 24 * time.Hour
+		}
+		from, to := clockOf(day, c.WorkStart), clockOf(day, end)
+		if from.Before(a) {
+			from = a
+		}
+		if to.After(b) {
+			to = b
+		}
+		if to.After(from) {
+			total += to.Sub(from)
+		}
+	}
+	return total
+}
+
+// Dynamic returns a human-readable difference between t and now in business time,
+// e.g. "in 2 business days", "3 business hours ago" or "now".
+// Within the same business day it counts working hours and minutes.
+// When no working time lies between them, e.g. on a weekend, it falls back
+// to TimeAgoInWords, so a missed deadline reads "about 5 hours ago".
+func (c *Calendar) Dynamic(t, now time.Time) string {
+	loc := c.Locale.orDefault()
+	if t.Sub(now).Abs() < time.Minute {
+		return loc.Now
+	}
+
+	if days := c.BusinessDaysBetween(now, t); days != 0 {
+		return businessPhrase(days, Day, loc)
+	}
+	d := c.BusinessTimeBetween(now, t)
+	switch abs := d.Abs(); {
+	case abs < time.Minute:
+		return TimeAgoInWords(t, now, DistanceOptions{Locale: loc})
+	case abs < time.Hour:
+		return businessPhrase(int(d/time.Minute), Minute, loc)
+	}
+	return businessPhrase(int(d/time.Hour), Hour, loc)
+}
+
+// LoadHolidaysFile loads holidays from an iCalendar (.ics) or a JSON (.json) file.
+func (c *Calendar) LoadHolidaysFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".ics", ".ical":
+		return c.LoadHolidaysICal(f)
+	case ".json":
+		return c.LoadHolidaysJSON(f)
+	}
+	return fmt.Errorf("dates: unknown holidays file format %q", path)
+}
+
+// LoadHolidaysJSON loads holidays from a JSON array of "2006-01-02" dates
+// or of objects like {"date": "2006-01-02", "name": "New Year"}.
+func (c *Calendar) LoadHolidaysJSON(r io.Reader) error {
+	var items []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&items); err != nil {
+		return fmt.Errorf("dates: decode holidays: %w", err)
+	}
+
+	for _, raw := range items {
+		var h struct {
+			Date string `json:"date"`
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(raw, &h.Date); err != nil {
+			if err := json.Unmarshal(raw, &h); err != nil {
+				return fmt.Errorf("dates: decode holiday %s: %w", raw, err)
+			}
+		}
+		t, err := time.ParseInLocation(time.DateOnly, h.Date, c.location())
+		if err != nil {
+			return fmt.Errorf("dates: decode holiday %s: %w", raw, err)
+		}
+		c.AddHoliday(t, h.Name)
+	}
+	return nil
+}
+
+// LoadHolidaysICal loads all-day and timed VEVENTs from an iCalendar stream.
+// Multi-day events mark every day until DTEND, which is exclusive.
+// Recurrence rules are not expanded.
+func (c *Calendar) LoadHolidaysICal(r io.Reader) error {
+	lines, err := unfoldICal(r)
+	if err != nil {
+		return fmt.Errorf("dates: read icalendar: %w", err)
+	}
+
+	var (
+		inEvent    bool
+		start, end time.Time
+		name       string
+	)
+	for _, line := range lines {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key, params, _ := strings.Cut(key, ";")
+
+		switch strings.ToUpper(key) {
+		case "BEGIN":
+			if strings.EqualFold(value, "VEVENT") {
+				inEvent, start, end, name = true, time.Time{}, time.Time{}, ""
+			}
+		case "DTSTART", "DTEND":
+			if !inEvent {
+				continue
+			}
+			t, err := parseICalDate(value, params, c.location())
+			if err != nil {
+				return err
+			}
+			if strings.EqualFold(key, "DTSTART") {
+				start = t
+			} else {
+				end = t
+			}
+		case "SUMMARY":
+			if inEvent {
+				name = unescapeICal(value)
+			}
+		case "END":
+			if !inEvent || !strings.EqualFold(value, "VEVENT") {
+				continue
+			}
+			inEvent = false
+			if start.IsZero() {
+				return fmt.Errorf("dates: icalendar event %q has no DTSTART", name)
+			}
+			c.AddHoliday(start, name)
+			for d := start.AddDate(0, 0, 1); d.Before(end); d = d.AddDate(0, 0, 1) {
+				c.AddHoliday(d, name)
+			}
+		}
+	}
+	return nil
+}
+
+// in converts t to the calendar location.
+func (c *Calendar) in(t time.Time) time.Time {
+	if c.Location == nil {
+		return t
+	}
+	return t.In(c.Location)
+}
+
+// location returns the calendar location or UTC if it is not set.
+func (c *Calendar) location() *time.Location {
+	if c.Location == nil {
+		return time.UTC
+	}
+	return c.Location
+}
+
+// hasWorkdays defines if any day of the week is not a weekend.
+func (c *Calendar) hasWorkdays() bool {
+	var off [7]bool
+	n := 0
+	for _, wd := range c.Weekend {
+		if wd >= time.Sunday && wd <= time.Saturday && !off[wd] {
+			off[wd] = true
+			n++
+		}
+	}
+	return n < len(off)
+}
+
+// businessPhrase renders n units of business time relative to now.
+func businessPhrase(n int, u Unit, loc *Locale) string {
+	format := loc.In
+	if n < 0 {
+		format, n = loc.Ago, -n
+	}
+	return loc.phrase(format, strconv.Itoa(n)+" "+loc.businessUnit(u, n))
+}
+
+// dateOf returns the calendar day of t.
+func dateOf(t time.Time) civilDate {
+	y, m, d := t.Date()
+	return civilDate{year: y, month: m, day: d}
+}
+
+// startOfDay returns the midnight of the day of t.
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// clockOf returns the wall clock time of the day that is offset after midnight.
+func clockOf(day time.Time, offset time.Duration) time.Time {
+	y, m, d := day.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, day.Location()).Add(offset)
+}
+
+// unfoldICal reads iCalendar content lines joining folded ones.
+func unfoldICal(r io.Reader) ([]string, error) {
+	var lines []string
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, sc.Err()
+}
+
+// parseICalDate parses DATE and DATE-TIME iCalendar values. UTC times end
+// with "Z", other times are in the TZID location, or in the floating one if absent.
+func parseICalDate(value, params string, floating *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+
+	loc := floating
+	for _, p := range strings.Split(params, ";") {
+		if k, v, ok := strings.Cut(p, "="); ok && strings.EqualFold(k, "TZID") {
+			if l, err := time.LoadLocation(v); err == nil {
+				loc = l
+			}
+		}
+	}
+
+	for _, layout := range []string{"20060102T150405", "20060102"} {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("dates: bad icalendar date %q", value)
+}
+
+// unescapeICal unescapes iCalendar text values.
+func unescapeICal(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	r := strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`)
+	return r.Replace(s)
+}
@@ -0,0 +1,255 @@
+package dates
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// date returns the time of the day at the given hour and minute in UTC.
+func date(y int, m time.Month, d, h, min int) time.Time {
+	return time.Date(y, m, d, h, min, 0, 0, time.UTC)
+}
+
+func TestCalendar_AddBusinessDays(t *testing.T) {
+	t.Parallel()
+
+	c := NewCalendar()
+	c.AddHoliday(date(2026, 3, 9, 0, 0), "Holiday")
+
+	type args struct {
+		t time.Time
+		n int
+	}
+	tests := []struct {
+		name string
+		args args
+		want time.Time
+	}{
+		{name: "within week", args: args{t: date(2026, 3, 2, 10, 0), n: 2}, want: date(2026, 3, 4, 10, 0)},
+		{name: "over weekend and holiday", args: args{t: date(2026, 3, 5, 10, 0), n: 2}, want: date(2026, 3, 10, 10, 0)},
+		{name: "from weekend", args: args{t: date(2026, 3, 7, 10, 0), n: 1}, want: date(2026, 3, 10, 10, 0)},
+		{name: "backwards", args: args{t: date(2026, 3, 10, 10, 0), n: -2}, want: date(2026, 3, 5, 10, 0)},
+		{name: "zero", args: args{t: date(2026, 3, 7, 10, 0), n: 0}, want: date(2026, 3, 7, 10, 0)},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, c.AddBusinessDays(tt.args.t, tt.args.n))
+		})
+	}
+}
+
+func TestCalendar_AddBusinessDaysNoWorkdays(t *testing.T) {
+	t.Parallel()
+
+	c := &Calendar{Weekend: []time.Weekday{
+		time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday,
+	}}
+	tm := date(2026, 3, 2, 10, 0)
+	require.Equal(t, tm, c.AddBusinessDays(tm, 1))
+	require.Equal(t, tm, c.AddBusinessDays(tm, -1))
+}
+
+func TestCalendar_AddHolidayLocation(t *testing.T) {
+	t.Parallel()
+
+	c := &Calendar{Location: time.FixedZone("MSK", 3*60*60)}
+	c.AddHoliday(date(2026, 3, 8, 22, 0), "International Women's Day")
+
+	_, ok := c.Holiday(date(2026, 3, 8, 12, 0))
+	require.False(t, ok)
+	name, ok := c.Holiday(date(2026, 3, 9, 12, 0))
+	require.True(t, ok)
+	require.Equal(t, "International Women's Day", name)
+}
+
+func TestCalendar_BusinessDaysBetween(t *testing.T) {
+	t.Parallel()
+
+	c := NewCalendar()
+	c.AddHoliday(date(2026, 3, 9, 0, 0), "Holiday")
+
+	tests := []struct {
+		name string
+		a, b time.Time
+		want int
+	}{
+		{name: "same day", a: date(2026, 3, 2, 9, 0), b: date(2026, 3, 2, 18, 0), want: 0},
+		{name: "two days", a: date(2026, 3, 2, 18, 0), b: date(2026, 3, 4, 9, 0), want: 2},
+		{name: "over weekend and holiday", a: date(2026, 3, 5, 10, 0), b: date(2026, 3, 10, 10, 0), want: 2},
+		{name: "backwards", a: date(2026, 3, 10, 10, 0), b: date(2026, 3, 5, 10, 0), want: -2},
+		{name: "weekend only", a: date(2026, 3, 6, 10, 0), b: date(2026, 3, 8, 10, 0), want: 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, c.BusinessDaysBetween(tt.a, tt.b))
+		})
+	}
+}
+
+func TestCalendar_BusinessTimeBetween(t *testing.T) {
+	t.Parallel()
+
+	c := NewCalendar()
+
+	require.Equal(t, 3*time.Hour, c.BusinessTimeBetween(date(2026, 3, 2, 7, 0), date(2026, 3, 2, 12, 0)))
+	require.Equal(t, 11*time.Hour, c.BusinessTimeBetween(date(2026, 3, 6, 10, 0), date(2026, 3, 9, 12, 0)))
+	require.Equal(t, -11*time.Hour, c.BusinessTimeBetween(date(2026, 3, 9, 12, 0), date(2026, 3, 6, 10, 0)))
+	require.Equal(t, 26*time.Hour, (&Calendar{}).BusinessTimeBetween(date(2026, 3, 6, 10, 0), date(2026, 3, 7, 12, 0)))
+}
+
+func TestCalendar_Dynamic(t *testing.T) {
+	t.Parallel()
+
+	c := NewCalendar()
+	now := date(2026, 3, 6, 10, 0) // Friday
+
+	tests := []struct {
+		t    time.Time
+		want string
+	}{
+		{t: now, want: "now"},
+		{t: date(2026, 3, 6, 10, 30), want: "in 30 business minutes"},
+		{t: date(2026, 3, 6, 15, 0), want: "in 5 business hours"},
+		{t: date(2026, 3, 6, 8, 0), want: "1 business hour ago"},
+		{t: date(2026, 3, 9, 9, 0), want: "in 1 business day"},
+		{t: date(2026, 3, 10, 9, 0), want: "in 2 business days"},
+		{t: date(2026, 3, 4, 9, 0), want: "2 business days ago"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, c.Dynamic(tt.t, now))
+		})
+	}
+}
+
+func TestCalendar_DynamicOutsideWorkingHours(t *testing.T) {
+	t.Parallel()
+
+	c := NewCalendar()
+	saturday := date(2026, 3, 7, 12, 0)
+	evening := date(2026, 3, 6, 19, 0)
+
+	require.Equal(t, "about 5 hours ago", c.Dynamic(saturday.Add(-5*time.Hour), saturday))
+	require.Equal(t, "in about 5 hours", c.Dynamic(saturday.Add(5*time.Hour), saturday))
+	require.Equal(t, "in about an hour", c.Dynamic(evening.Add(time.Hour), evening))
+	require.Equal(t, "about an hour ago", c.Dynamic(evening, evening.Add(time.Hour)))
+}
+
+func TestCalendar_DynamicLocale(t *testing.T) {
+	t.Parallel()
+
+	c := NewCalendar()
+	c.Locale = Russian
+	now := date(2026, 3, 6, 10, 0) // Friday
+
+	tests := []struct {
+		t    time.Time
+		want string
+	}{
+		{t: now, want: "сейчас"},
+		{t: date(2026, 3, 6, 10, 1), want: "через 1 рабочую минуту"},
+		{t: date(2026, 3, 6, 10, 30), want: "через 30 рабочих минут"},
+		{t: date(2026, 3, 6, 8, 0), want: "1 рабочий час назад"},
+		{t: date(2026, 3, 10, 9, 0), want: "через 2 рабочих дня"},
+		{t: date(2026, 2, 27, 9, 0), want: "5 рабочих дней назад"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, c.Dynamic(tt.t, now))
+		})
+	}
+}
+
+func TestCalendar_LoadHolidays(t *testing.T) {
+	t.Parallel()
+
+	c := &Calendar{}
+	require.NoError(t, c.LoadHolidaysFile("testdata/holidays.ics"))
+	require.NoError(t, c.LoadHolidaysFile("testdata/holidays.json"))
+
+	tests := []struct {
+		t        time.Time
+		wantName string
+		wantOK   bool
+	}{
+		{t: date(2026, 1, 1, 12, 0), wantName: "New Year Holidays", wantOK: true},
+		{t: date(2026, 1, 2, 12, 0), wantName: "New Year Holidays", wantOK: true},
+		{t: date(2026, 1, 3, 12, 0)},
+		{t: date(2026, 3, 9, 12, 0), wantName: "International Women, Day", wantOK: true},
+		{t: date(2026, 5, 1, 12, 0), wantName: "Labour Day", wantOK: true},
+		{t: date(2026, 5, 11, 12, 0), wantOK: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.t.Format(time.DateOnly), func(t *testing.T) {
+			t.Parallel()
+
+			name, ok := c.Holiday(tt.t)
+			require.Equal(t, tt.wantOK, ok)
+			require.Equal(t, tt.wantName, name)
+			require.Equal(t, !tt.wantOK, c.IsBusinessDay(tt.t))
+		})
+	}
+}
+
+func TestCalendar_LoadHolidaysICalZones(t *testing.T) {
+	t.Parallel()
+
+	c := &Calendar{Location: time.UTC}
+	require.NoError(t, c.LoadHolidaysICal(strings.NewReader(strings.Join([]string{
+		"BEGIN:VEVENT",
+		"SUMMARY:UTC",
+		"DTSTART;TZID=Asia/Tokyo:20260302T020000Z",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Tokyo",
+		"DTSTART;TZID=Asia/Tokyo:20260305T020000",
+		"END:VEVENT",
+	}, "\r\n"))))
+
+	tests := []struct {
+		t        time.Time
+		wantName string
+		wantOK   bool
+	}{
+		{t: date(2026, 3, 1, 12, 0)},
+		{t: date(2026, 3, 2, 12, 0), wantName: "UTC", wantOK: true},
+		{t: date(2026, 3, 4, 12, 0), wantName: "Tokyo", wantOK: true},
+		{t: date(2026, 3, 5, 12, 0)},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.t.Format(time.DateOnly), func(t *testing.T) {
+			t.Parallel()
+
+			name, ok := c.Holiday(tt.t)
+			require.Equal(t, tt.wantOK, ok)
+			require.Equal(t, tt.wantName, name)
+		})
+	}
+}
+
+func TestCalendar_LoadHolidaysErrors(t *testing.T) {
+	t.Parallel()
+
+	c := NewCalendar()
+	require.Error(t, c.LoadHolidaysJSON(strings.NewReader(`[{"date": "01/02/2026"}]`)))
+	require.Error(t, c.LoadHolidaysJSON(strings.NewReader(`{}`)))
+	require.Error(t, c.LoadHolidaysICal(strings.NewReader("BEGIN:VEVENT\nSUMMARY:x\nEND:VEVENT\n")))
+	require.Error(t, c.LoadHolidaysFile("testdata/holidays.txt"))
+}
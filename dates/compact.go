@@ -0,0 +1,104 @@
+package dates
+
+import (
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// CompactOptions configures DynamicShort.
+type CompactOptions struct {
+	// Narrow uses the narrowest unit abbreviations of the locale.
+	Narrow bool
+	// MaxWidth limits the number of characters in the result.
+	// When the text does not fit, the narrow variant is tried and then
+	// the larger units, e.g. "1y" for "12mo". Numbers are never cut,
+	// so "…" is returned if nothing fits. Zero means no limit.
+	MaxWidth int
+	// Locale provides unit abbreviations, English by default.
+	Locale *Locale
+}
+
+// DynamicShort returns a compact time difference string for narrow UIs,
+// e.g. "now", "5m", "3h" or "2d". It uses the same thresholds as Dynamic
+// and counts days, weeks, months and years where Dynamic shows a date.
+func DynamicShort(t time.Time, opts CompactOptions) string {
+	return dynamicShort(t, time.Now(), opts)
+}
+
+// dynamicShort returns a compact difference between t and now.
+func dynamicShort(t, now time.Time, opts CompactOptions) string {
+	loc := opts.Locale.orDefault()
+	diff := now.Sub(t)
+	n, u := compactValue(diff)
+
+	s := compactText(n, u, opts.Narrow, loc)
+	if opts.MaxWidth <= 0 || utf8.RuneCountInString(s) <= opts.MaxWidth {
+		return s
+	}
+	if u == 0 {
+		return truncateRunes(s, opts.MaxWidth)
+	}
+	if s = compactText(n, u, true, loc); utf8.RuneCountInString(s) <= opts.MaxWidth {
+		return s
+	}
+
+	for larger := u + 1; larger <= Year; larger++ {
+		n := int((diff + larger.Duration()/2) / larger.Duration())
+		if n == 0 {
+			break
+		}
+		if s = compactText(n, larger, true, loc); utf8.RuneCountInString(s) <= opts.MaxWidth {
+			return s
+		}
+	}
+	return "…"
+}
+
+// compactValue returns the number of units the difference is shown in.
+// A zero unit stands for "now".
+func compactValue(diff time.Duration) (int, Unit) {
+	switch spanOf(diff) {
+	case spanNow:
+		return 0, 0
+	case spanMinutes:
+		return int(diff / time.Minute), Minute
+	case spanToday:
+		return int(diff / time.Hour), Hour
+	case spanYesterday:
+		return 1, Day
+	}
+
+	for _, u := range []Unit{Year, Month, Week} {
+		if diff >= u.Duration() {
+			return int(diff / u.Duration()), u
+		}
+	}
+	return int(diff / Day.Duration()), Day
+}
+
+// compactText renders n units with abbreviated or narrow unit names.
+func compactText(n int, u Unit, narrow bool, loc *Locale) string {
+	if u == 0 {
+		return loc.Now
+	}
+	if narrow {
+		if abbr, ok := loc.NarrowUnits[u]; ok {
+			return strconv.Itoa(n) + abbr
+		}
+		return strconv.Itoa(n) + loc.ShortUnits[u]
+	}
+	return strconv.Itoa(n) + loc.ShortUnitSep + loc.ShortUnits[u]
+}
+
+// truncateRunes cuts the word to width characters ending with an ellipsis.
+func truncateRunes(s string, width int) string {
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+	if width <= 1 {
+		return string(runes[:width])
+	}
+	return string(runes[:width-1]) + "…"
+}
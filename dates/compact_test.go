@@ -0,0 +1,88 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamicShort(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 4, 15, 0, 0, 0, time.UTC)
+
+	type args struct {
+		t    time.Time
+		opts CompactOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{name: "now", args: args{t: now.Add(-30 * time.Second)}, want: "now"},
+		{name: "future", args: args{t: now.Add(time.Hour)}, want: "now"},
+		{name: "minutes", args: args{t: now.Add(-5 * time.Minute)}, want: "5m"},
+		{name: "hours", args: args{t: now.Add(-3*time.Hour - 59*time.Minute)}, want: "3h"},
+		{name: "yesterday", args: args{t: now.Add(-30 * time.Hour)}, want: "1d"},
+		{name: "days", args: args{t: now.Add(-50 * time.Hour)}, want: "2d"},
+		{name: "weeks", args: args{t: now.AddDate(0, 0, -15)}, want: "2w"},
+		{name: "months", args: args{t: now.AddDate(0, 0, -100)}, want: "3mo"},
+		{name: "years", args: args{t: now.AddDate(-2, 0, -1)}, want: "2y"},
+		{
+			name: "russian",
+			args: args{t: now.Add(-5 * time.Minute), opts: CompactOptions{Locale: Russian}},
+			want: "5 мин",
+		},
+		{
+			name: "russian narrow",
+			args: args{t: now.Add(-5 * time.Minute), opts: CompactOptions{Locale: Russian, Narrow: true}},
+			want: "5м",
+		},
+		{
+			name: "max width falls back to narrow",
+			args: args{t: now.Add(-15 * time.Minute), opts: CompactOptions{Locale: Russian, MaxWidth: 3}},
+			want: "15м",
+		},
+		{
+			name: "max width falls back to a larger unit",
+			args: args{t: now.AddDate(0, 0, -360), opts: CompactOptions{MaxWidth: 2}},
+			want: "1y",
+		},
+		{
+			name: "max width rounds the larger unit",
+			args: args{t: now.AddDate(0, 0, -330), opts: CompactOptions{MaxWidth: 3}},
+			want: "1y",
+		},
+		{
+			name: "max width skips zero larger units",
+			args: args{t: now.AddDate(0, 0, -100), opts: CompactOptions{MaxWidth: 2}},
+			want: "…",
+		},
+		{
+			name: "max width never cuts numbers",
+			args: args{t: now.AddDate(-12, 0, 0), opts: CompactOptions{MaxWidth: 2}},
+			want: "…",
+		},
+		{
+			name: "max width cuts now",
+			args: args{t: now, opts: CompactOptions{Locale: Russian, MaxWidth: 4}},
+			want: "сей…",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, dynamicShort(tt.args.t, now, tt.args.opts))
+		})
+	}
+}
+
+func TestDynamicShort_Now(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "5m", DynamicShort(time.Now().Add(-5*time.Minute-time.Second), CompactOptions{}))
+}
@@ -2,24 +2,46 @@ package dates
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
+// span describes how far a point in time is from now
+type span int8
+
+const (
+	// spanNow describes less than a minute ago or the future
+	spanNow span = iota + 1
+	// spanMinutes describes less than an hour ago
+	spanMinutes
+	// spanToday describes less than a day ago
+	spanToday
+	// spanYesterday describes less than two days ago
+	spanYesterday
+	// spanDate describes two days ago and earlier
+	spanDate
+)
+
 // Dynamic returns a human-readable time difference string.
 func Dynamic(t time.Time) string {
-	diff := time.Since(t)
+	return dynamic(t, time.Now())
+}
 
-	switch {
-	case diff < time.Minute:
+// dynamic returns a human-readable difference between t and now.
+func dynamic(t, now time.Time) string {
+	diff := now.Sub(t)
+
+	switch spanOf(diff) {
+	case spanNow:
 		// Less than a minute, return "now".
 		return "now"
-	case diff < time.Hour:
+	case spanMinutes:
 		// Less than an hour, return the number of minutes.
 		return fmt.Sprintf("%d minutes ago", int(diff.Minutes()))
-	case diff < time.Hour*24:
+	case spanToday:
 		// Today
 		return fmt.Sprintf("today %s:%s", zerofy(t.Hour()), zerofy(t.Minute()))
-	case diff < time.Hour*24*2:
+	case spanYesterday:
 		// Yesterday
 		return fmt.Sprintf("yesterday %s:%s", zerofy(t.Hour()), zerofy(t.Minute()))
 	}
@@ -27,22 +49,76 @@ func Dynamic(t time.Time) string {
 	return t.Format("02.01.2006 15:04")
 }
 
+// localDynamic returns the text of dynamic in the locale. English and nil
+// keep the exact text of Dynamic, which ParseRelative reads back.
+func localDynamic(t, now time.Time, loc *Locale) string {
+	if loc == nil || loc == English {
+		return dynamic(t, now)
+	}
+	diff := now.Sub(t)
+
+	switch spanOf(diff) {
+	case spanNow:
+		return loc.Now
+	case spanMinutes:
+		return loc.phrase(loc.Ago, unitPhrase(int(diff.Minutes()), Minute, loc))
+	case spanToday:
+		return strings.ToLower(loc.Today) + " " + t.Format("15:04")
+	case spanYesterday:
+		return strings.ToLower(loc.Yesterday) + " " + t.Format("15:04")
+	}
+
+	return t.Format("02.01.2006 15:04")
+}
+
+// DynamicWithExpiry returns the same text as Dynamic would at now and the instant
+// at which the text changes next, so a view can schedule a single re-render.
+// The zero time is returned when the text never changes.
+func DynamicWithExpiry(t, now time.Time) (text string, validUntil time.Time) {
+	diff := now.Sub(t)
+
+	switch spanOf(diff) {
+	case spanNow:
+		validUntil = t.Add(time.Minute)
+	case spanMinutes:
+		validUntil = t.Add(diff.Truncate(time.Minute) + time.Minute)
+	case spanToday:
+		validUntil = t.Add(time.Hour * 24)
+	case spanYesterday:
+		validUntil = t.Add(time.Hour * 24 * 2)
+	}
+
+	return dynamic(t, now), validUntil
+}
+
+// spanOf returns the span the time difference falls into.
+func spanOf(diff time.Duration) span {
+	switch {
+	case diff < time.Minute:
+		return spanNow
+	case diff < time.Hour:
+		return spanMinutes
+	case diff < time.Hour*24:
+		return spanToday
+	case diff < time.Hour*24*2:
+		return spanYesterday
+	}
+	return spanDate
+}
+
 // ShortDMY returns a short date string in the format "day month year".
 func ShortDMY(t time.Time) string {
-	return fmt.Sprintf("%d %s %d", t.Day(), t.Month().String()[:3], t.Year())
+	return Strftime(t, "%-d %b %Y")
 }
 
 // ShortMY returns a short date string in the format "month year".
 func ShortMY(t time.Time) string {
-	return fmt.Sprintf("%s %d", t.Month().String()[:3], t.Year())
+	return Strftime(t, "%b %Y")
 }
 
 // ShortMYHM returns a short date string in the format "month year hour:minute".
 func ShortMYHM(t time.Time) string {
-	return fmt.Sprintf(
-		"%s %d %s:%s",
-		t.Month().String()[:3], t.Year(), zerofy(t.Hour()), zerofy(t.Minute()),
-	)
+	return Strftime(t, "%b %Y %H:%M")
 }
 
 // zerofy adds a leading zero to single-digit numbers.
@@ -54,6 +54,35 @@ func TestDynamic(t *testing.T) {
 	}
 }
 
+func TestLocalDynamic(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 4, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		t    time.Time
+		loc  *Locale
+		want string
+	}{
+		{name: "english", t: now.Add(-time.Minute), loc: English, want: "1 minutes ago"},
+		{name: "nil", t: now.Add(-time.Hour), want: "today 14:00"},
+		{name: "russian now", t: now.Add(-time.Second), loc: Russian, want: "сейчас"},
+		{name: "russian minute", t: now.Add(-time.Minute), loc: Russian, want: "минуту назад"},
+		{name: "russian minutes", t: now.Add(-5 * time.Minute), loc: Russian, want: "5 минут назад"},
+		{name: "russian today", t: now.Add(-time.Hour), loc: Russian, want: "сегодня 14:00"},
+		{name: "russian yesterday", t: now.Add(-25 * time.Hour), loc: Russian, want: "вчера 14:00"},
+		{name: "russian date", t: now.AddDate(0, 0, -3), loc: Russian, want: "01.03.2026 15:00"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, localDynamic(tt.t, now, tt.loc))
+		})
+	}
+}
+
 func TestShortDMY(t *testing.T) {
 	t.Parallel()
 
@@ -138,3 +167,73 @@ func TestShortMYHM(t *testing.T) {
 		})
 	}
 }
+
+func TestDynamicWithExpiry(t *testing.T) {
+	t.Parallel()
+
+	tm := time.Date(2026, 3, 4, 15, 20, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		now       time.Time
+		wantText  string
+		wantUntil time.Time
+	}{
+		{
+			name:      "future",
+			now:       tm.Add(-time.Hour),
+			wantText:  "now",
+			wantUntil: tm.Add(time.Minute),
+		},
+		{
+			name:      "now",
+			now:       tm.Add(10 * time.Second),
+			wantText:  "now",
+			wantUntil: tm.Add(time.Minute),
+		},
+		{
+			name:      "minutes",
+			now:       tm.Add(5*time.Minute + 30*time.Second),
+			wantText:  "5 minutes ago",
+			wantUntil: tm.Add(6 * time.Minute),
+		},
+		{
+			name:      "last minute before today",
+			now:       tm.Add(59 * time.Minute),
+			wantText:  "59 minutes ago",
+			wantUntil: tm.Add(time.Hour),
+		},
+		{
+			name:      "today",
+			now:       tm.Add(3 * time.Hour),
+			wantText:  "today 15:20",
+			wantUntil: tm.Add(24 * time.Hour),
+		},
+		{
+			name:      "yesterday",
+			now:       tm.Add(25 * time.Hour),
+			wantText:  "yesterday 15:20",
+			wantUntil: tm.Add(48 * time.Hour),
+		},
+		{
+			name:     "date",
+			now:      tm.Add(72 * time.Hour),
+			wantText: "04.03.2026 15:20",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			text, until := DynamicWithExpiry(tm, tt.now)
+
+			require.Equal(t, tt.wantText, text)
+			require.Equal(t, tt.wantUntil, until)
+			if !until.IsZero() {
+				require.Equal(t, text, dynamic(tm, until.Add(-time.Nanosecond)))
+				require.NotEqual(t, text, dynamic(tm, until))
+			}
+		})
+	}
+}
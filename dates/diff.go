@@ -0,0 +1,240 @@
+package dates
+
+import (
+	"strings"
+	"time"
+)
+
+// Period is a calendar-aware amount of time.
+// All the fields of a negative period are zero or negative.
+type Period struct {
+	Years       int
+	Months      int
+	Days        int
+	Hours       int
+	Minutes     int
+	Seconds     int
+	Nanoseconds int
+}
+
+// Diff returns the calendar difference between a and b so that
+// Diff(a, b).AddTo(a) equals b. Months are added with month-end clamping,
+// so the difference between 31 January and 28 February is one month and
+// a birthday on 29 February turns a year older on 28 February.
+// The time b is converted to the location of a. The clock part is the
+// elapsed time, so Hours reaches 24 within a 25-hour day when the clocks
+// go back, since the next day has not started yet.
+func Diff(a, b time.Time) Period {
+	b = b.In(a.Location())
+
+	// after reports if x went past b in the direction from a to b.
+	after, step := func(x time.Time) bool { return x.After(b) }, 1
+	if b.Before(a) {
+		after, step = func(x time.Time) bool { return x.Before(b) }, -1
+	}
+
+	months := (b.Year()-a.Year())*12 + int(b.Month()-a.Month())
+	if after(addMonths(a, months)) {
+		months -= step
+	}
+	t := addMonths(a, months)
+
+	days := int(b.Sub(t) / (24 * time.Hour))
+	for days != 0 && after(t.AddDate(0, 0, days)) {
+		days -= step
+	}
+	for !after(t.AddDate(0, 0, days+step)) {
+		days += step
+	}
+	rest := b.Sub(t.AddDate(0, 0, days))
+
+	return Period{
+		Years:       months / 12,
+		Months:      months % 12,
+		Days:        days,
+		Hours:       int(rest / time.Hour),
+		Minutes:     int(rest % time.Hour / time.Minute),
+		Seconds:     int(rest % time.Minute / time.Second),
+		Nanoseconds: int(rest % time.Second),
+	}
+}
+
+// AddTo returns t moved by the period. Years and months are added first
+// clamping the day to the end of the month, then days, then the time.
+func (p Period) AddTo(t time.Time) time.Time {
+	t = addMonths(t, p.Years*12+p.Months)
+	t = t.AddDate(0, 0, p.Days)
+	return t.Add(p.clock())
+}
+
+// IsZero defines if the period is empty.
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// FormatPeriod returns a human-readable representation of the period, e.g.
+// "2 years 3 months", using the style, unit range, precision and locale of opts.
+// Largest defaults to Year. Units above Largest are folded into it counting
+// 30 days in a month. The smallest shown unit is rounded with opts.Rounding
+// counting months and years as 30 and 365 days, and a value that reaches
+// the next unit is carried into it, e.g. 1 hour 59 minutes rounded up
+// to hours gives "2 hours".
+func FormatPeriod(p Period, opts DurationOptions) string {
+	loc := opts.Locale.orDefault()
+	if opts.Style == 0 {
+		opts.Style = DurationLong
+	}
+	if opts.Largest == 0 {
+		opts.Largest = Year
+	}
+	if opts.Smallest == 0 {
+		opts.Smallest = Second
+	}
+	opts.Largest, opts.Smallest = clampUnit(opts.Largest), clampUnit(opts.Smallest)
+	if opts.Smallest > opts.Largest {
+		opts.Smallest = opts.Largest
+	}
+	if opts.Precision <= 0 && opts.Style == DurationApprox {
+		opts.Precision = 1
+	}
+
+	sign := ""
+	if p.Years < 0 || p.Months < 0 || p.Days < 0 || p.clock() < 0 {
+		sign, p = "-", p.neg()
+	}
+
+	values := p.unitValues(opts.Largest)
+	shown := unitsBetween(opts.Largest, opts.Smallest)
+	lead := len(shown) - 1
+	for i, u := range shown {
+		if values[u] != 0 {
+			lead = i
+			break
+		}
+	}
+	last := len(shown) - 1
+	if opts.Precision > 0 && lead+opts.Precision-1 < last {
+		last = lead + opts.Precision - 1
+	}
+
+	rest := time.Duration(p.Nanoseconds)
+	for _, u := range units {
+		if u < shown[last] {
+			rest += time.Duration(values[u]) * u.Duration()
+		}
+	}
+	values[shown[last]] += roundCount(rest, shown[last].Duration(), opts.Rounding)
+	for i := last; i > 0; i-- {
+		u, up := shown[i], shown[i-1]
+		r := carryRatio(u, up)
+		if r == 0 || values[u] < r {
+			break
+		}
+		values[up], values[u] = values[up]+values[u]/r, values[u]%r
+	}
+
+	parts := make([]string, 0, last+1)
+	for _, u := range shown[:last+1] {
+		if values[u] != 0 {
+			parts = append(parts, formatUnit(values[u], u, opts.Style, loc))
+		}
+	}
+	if len(parts) == 0 {
+		parts = append(parts, formatUnit(0, shown[last], opts.Style, loc))
+	}
+
+	s := sign + strings.Join(parts, " ")
+	if opts.Style == DurationApprox && rest != 0 && loc.About != "" {
+		s = loc.About + " " + s
+	}
+	return s
+}
+
+// carryRatio returns how many of u make one up, or zero if they do not
+// make a whole number of it.
+func carryRatio(u, up Unit) int {
+	switch {
+	case u == Second && up == Minute, u == Minute && up == Hour:
+		return 60
+	case u == Hour && up == Day:
+		return 24
+	case u == Day && up == Week:
+		return 7
+	case u == Month && up == Year:
+		return 12
+	}
+	return 0
+}
+
+// addMonths adds n months to t clamping the day to the end of the month.
+func addMonths(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	first := time.Date(y, m+time.Month(n), 1, 0, 0, 0, 0, t.Location())
+	if last := daysIn(first.Year(), first.Month()); d > last {
+		d = last
+	}
+	hh, mm, ss := t.Clock()
+	return time.Date(first.Year(), first.Month(), d, hh, mm, ss, t.Nanosecond(), t.Location())
+}
+
+// daysIn returns the number of days in the month.
+func daysIn(y int, m time.Month) int {
+	return time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// neg returns the period with all the fields negated.
+func (p Period) neg() Period {
+	return Period{
+		Years:       -p.Years,
+		Months:      -p.Months,
+		Days:        -p.Days,
+		Hours:       -p.Hours,
+		Minutes:     -p.Minutes,
+		Seconds:     -p.Seconds,
+		Nanoseconds: -p.Nanoseconds,
+	}
+}
+
+// clock returns the time part of the period.
+func (p Period) clock() time.Duration {
+	return time.Duration(p.Hours)*time.Hour +
+		time.Duration(p.Minutes)*time.Minute +
+		time.Duration(p.Seconds)*time.Second +
+		time.Duration(p.Nanoseconds)
+}
+
+// unitValues splits the period into units not larger than largest.
+func (p Period) unitValues(largest Unit) map[Unit]int {
+	v := map[Unit]int{
+		Year:   p.Years,
+		Month:  p.Months,
+		Day:    p.Days,
+		Hour:   p.Hours,
+		Minute: p.Minutes,
+		Second: p.Seconds,
+	}
+	if largest < Year {
+		v[Month] += v[Year] * 12
+		v[Year] = 0
+	}
+	if largest < Month {
+		v[Day] += v[Month] * 30
+		v[Month] = 0
+	}
+	if largest >= Week {
+		v[Week], v[Day] = v[Day]/7, v[Day]%7
+	}
+	if largest < Day {
+		v[Hour] += v[Day] * 24
+		v[Day] = 0
+	}
+	if largest < Hour {
+		v[Minute] += v[Hour] * 60
+		v[Hour] = 0
+	}
+	if largest < Minute {
+		v[Second] += v[Minute] * 60
+		v[Minute] = 0
+	}
+	return v
+}
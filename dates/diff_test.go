@@ -0,0 +1,190 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		a, b time.Time
+		want Period
+	}{
+		{
+			name: "same time",
+			a:    date(2026, 3, 4, 10, 0),
+			b:    date(2026, 3, 4, 10, 0),
+			want: Period{},
+		},
+		{
+			name: "age",
+			a:    date(1991, 10, 20, 0, 0),
+			b:    date(2026, 10, 19, 0, 0),
+			want: Period{Years: 34, Months: 11, Days: 29},
+		},
+		{
+			name: "member for",
+			a:    date(2024, 7, 15, 9, 30),
+			b:    date(2026, 10, 19, 12, 45),
+			want: Period{Years: 2, Months: 3, Days: 4, Hours: 3, Minutes: 15},
+		},
+		{
+			name: "month end clamping",
+			a:    date(2026, 1, 31, 0, 0),
+			b:    date(2026, 2, 28, 0, 0),
+			want: Period{Months: 1},
+		},
+		{
+			name: "month end clamping with days",
+			a:    date(2026, 1, 31, 0, 0),
+			b:    date(2026, 3, 1, 0, 0),
+			want: Period{Months: 1, Days: 1},
+		},
+		{
+			name: "leap day birthday",
+			a:    date(2024, 2, 29, 0, 0),
+			b:    date(2025, 2, 28, 0, 0),
+			want: Period{Years: 1},
+		},
+		{
+			name: "not yet a month",
+			a:    date(2026, 3, 15, 12, 0),
+			b:    date(2026, 4, 15, 11, 0),
+			want: Period{Days: 30, Hours: 23},
+		},
+		{
+			name: "negative",
+			a:    date(2026, 3, 31, 0, 0),
+			b:    date(2026, 2, 28, 0, 0),
+			want: Period{Months: -1},
+		},
+		{
+			name: "negative with time",
+			a:    date(2026, 10, 19, 12, 0),
+			b:    date(2026, 10, 8, 6, 30),
+			want: Period{Days: -11, Hours: -5, Minutes: -30},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Diff(tt.a, tt.b)
+
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.b, got.AddTo(tt.a))
+		})
+	}
+}
+
+func TestDiff_DST(t *testing.T) {
+	t.Parallel()
+
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	a := time.Date(2026, 3, 28, 12, 0, 0, 0, berlin)
+	b := time.Date(2026, 3, 30, 12, 0, 0, 0, berlin)
+
+	require.Equal(t, Period{Days: 2}, Diff(a, b))
+
+	a = time.Date(2026, 10, 25, 0, 0, 0, 0, berlin)
+	b = time.Date(2026, 10, 25, 23, 30, 0, 0, berlin)
+	require.Equal(t, Period{Hours: 24, Minutes: 30}, Diff(a, b))
+	require.Equal(t, b, Diff(a, b).AddTo(a))
+
+	b = time.Date(2026, 10, 26, 0, 30, 0, 0, berlin)
+	require.Equal(t, Period{Days: 1, Minutes: 30}, Diff(a, b))
+}
+
+func TestFormatPeriod(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		p    Period
+		opts DurationOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "zero",
+			args: args{p: Period{}, opts: DurationOptions{Smallest: Day}},
+			want: "0 days",
+		},
+		{
+			name: "member for",
+			args: args{p: Period{Years: 2, Months: 3, Days: 4, Hours: 1}, opts: DurationOptions{Precision: 2}},
+			want: "2 years 3 months",
+		},
+		{
+			name: "age",
+			args: args{p: Period{Years: 34, Months: 11}, opts: DurationOptions{Smallest: Year, Rounding: RoundFloor}},
+			want: "34 years",
+		},
+		{
+			name: "renews in",
+			args: args{p: Period{Days: 11, Hours: 5}, opts: DurationOptions{Smallest: Day, Largest: Day}},
+			want: "11 days",
+		},
+		{
+			name: "weeks",
+			args: args{p: Period{Days: 10}},
+			want: "1 week 3 days",
+		},
+		{
+			name: "folded months",
+			args: args{p: Period{Years: 1, Months: 2}, opts: DurationOptions{Largest: Month}},
+			want: "14 months",
+		},
+		{
+			name: "approximate",
+			args: args{p: Period{Years: 1, Months: 2}, opts: DurationOptions{Style: DurationApprox}},
+			want: "about 1 year",
+		},
+		{
+			name: "approximate rounds to nearest",
+			args: args{p: Period{Years: 1, Months: 11}, opts: DurationOptions{Style: DurationApprox}},
+			want: "about 2 years",
+		},
+		{
+			name: "precision rounds to nearest",
+			args: args{p: Period{Years: 2, Months: 3, Days: 20}, opts: DurationOptions{Precision: 2}},
+			want: "2 years 4 months",
+		},
+		{
+			name: "ceil rounding carries",
+			args: args{p: Period{Hours: 1, Minutes: 59}, opts: DurationOptions{Smallest: Hour, Rounding: RoundCeil}},
+			want: "2 hours",
+		},
+		{
+			name: "rounding carries into years",
+			args: args{p: Period{Years: 1, Months: 11, Days: 20}, opts: DurationOptions{Smallest: Month}},
+			want: "2 years",
+		},
+		{
+			name: "short negative",
+			args: args{p: Period{Months: -1, Days: -2}, opts: DurationOptions{Style: DurationShort}},
+			want: "-1mo 2d",
+		},
+		{
+			name: "russian",
+			args: args{p: Period{Years: 2, Months: 5}, opts: DurationOptions{Locale: Russian}},
+			want: "2 года 5 месяцев",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, FormatPeriod(tt.args.p, tt.args.opts))
+		})
+	}
+}
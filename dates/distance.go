@@ -0,0 +1,102 @@
+package dates
+
+import (
+	"fmt"
+	"time"
+)
+
+// DistanceOptions describes how DistanceInWords and TimeAgoInWords approximate.
+type DistanceOptions struct {
+	// Rounding is applied to the counted units, RoundNearest by default.
+	// For years it picks between "about", "over" and "almost":
+	// RoundFloor never says "almost", RoundCeil never says "over".
+	Rounding Rounding
+	// Locale provides unit names and phrases, English by default.
+	Locale *Locale
+}
+
+// DistanceInWords returns the approximate distance between a and b
+// in the manner of Rails' distance_of_time_in_words, e.g. "less than a minute",
+// "about an hour", "3 days", "about 5 months", "over a year" or "almost 2 years".
+// The order of a and b does not matter.
+func DistanceInWords(a, b time.Time, opts DistanceOptions) string {
+	loc := opts.Locale.orDefault()
+	if b.Before(a) {
+		a, b = b, a
+	}
+	d := b.Sub(a)
+	r := opts.Rounding
+
+	const day = 24 * time.Hour
+	mins := roundCount(d, time.Minute, r)
+	switch {
+	case mins == 0:
+		return loc.LessThanMinute
+	case mins < 45:
+		return unitPhrase(mins, Minute, loc)
+	case mins < 90:
+		return loc.about(unitPhrase(1, Hour, loc))
+	case mins < 24*60:
+		return loc.about(unitPhrase(roundCount(d, time.Hour, r), Hour, loc))
+	case mins < 42*60:
+		return unitPhrase(1, Day, loc)
+	case mins < 30*24*60:
+		return unitPhrase(roundCount(d, day, r), Day, loc)
+	}
+
+	p := Diff(a, b)
+	if p.Years == 0 {
+		months := min(roundCount(d, Month.Duration(), r), 12)
+		return loc.about(unitPhrase(months, Month, loc))
+	}
+
+	years := p.Years
+	switch {
+	case p.Months < 3:
+		return loc.about(unitPhrase(years, Year, loc))
+	case r == RoundFloor || (r != RoundCeil && p.Months < 9):
+		return loc.phrase(loc.Over, unitPhrase(years, Year, loc))
+	}
+	return loc.phrase(loc.Almost, unitPhrase(years+1, Year, loc))
+}
+
+// TimeAgoInWords returns the approximate distance between t and now
+// relative to now, e.g. "about 3 months ago" or "in almost 2 years".
+// See DistanceInWords for the phrasing.
+func TimeAgoInWords(t, now time.Time, opts DistanceOptions) string {
+	loc := opts.Locale.orDefault()
+	s := DistanceInWords(t, now, opts)
+	if t.After(now) {
+		return loc.phrase(loc.In, s)
+	}
+	return loc.phrase(loc.Ago, s)
+}
+
+// roundCount returns the number of whole m in d rounded with r.
+func roundCount(d, m time.Duration, r Rounding) int {
+	return int(roundDuration(d, m, r) / m)
+}
+
+// unitPhrase renders n units preferring the single unit word for one.
+func unitPhrase(n int, u Unit, loc *Locale) string {
+	if s := loc.Singles[u]; n == 1 && s != "" {
+		return s
+	}
+	return formatUnit(n, u, DurationLong, loc)
+}
+
+// about prefixes s with the approximation word of the locale.
+func (l *Locale) about(s string) string {
+	if l.About == "" {
+		return s
+	}
+	return l.About + " " + s
+}
+
+// phrase applies the fmt format of the locale to s, if any.
+func (l *Locale) phrase(format, s string) string {
+	if format == "" {
+		return s
+	}
+	return fmt.Sprintf(format, s)
+}
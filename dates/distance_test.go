@@ -0,0 +1,83 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistanceInWords(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	type args struct {
+		to   time.Time
+		opts DistanceOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{name: "seconds", args: args{to: from.Add(20 * time.Second)}, want: "less than a minute"},
+		{name: "one minute", args: args{to: from.Add(50 * time.Second)}, want: "a minute"},
+		{name: "minutes", args: args{to: from.Add(44 * time.Minute)}, want: "44 minutes"},
+		{name: "about an hour", args: args{to: from.Add(45 * time.Minute)}, want: "about an hour"},
+		{name: "about hours", args: args{to: from.Add(150 * time.Minute)}, want: "about 3 hours"},
+		{name: "about hours floor", args: args{to: from.Add(150 * time.Minute), opts: DistanceOptions{Rounding: RoundFloor}}, want: "about 2 hours"},
+		{name: "a day", args: args{to: from.Add(30 * time.Hour)}, want: "a day"},
+		{name: "days", args: args{to: from.Add(5*24*time.Hour + 13*time.Hour)}, want: "6 days"},
+		{name: "days floor", args: args{to: from.Add(5*24*time.Hour + 13*time.Hour), opts: DistanceOptions{Rounding: RoundFloor}}, want: "5 days"},
+		{name: "about a month", args: args{to: from.AddDate(0, 0, 35)}, want: "about a month"},
+		{name: "about 2 months", args: args{to: from.AddDate(0, 0, 50)}, want: "about 2 months"},
+		{name: "months", args: args{to: from.AddDate(0, 0, 100)}, want: "about 3 months"},
+		{name: "months ceil", args: args{to: from.AddDate(0, 0, 100), opts: DistanceOptions{Rounding: RoundCeil}}, want: "about 4 months"},
+		{name: "about a year", args: args{to: from.AddDate(1, 1, 0)}, want: "about a year"},
+		{name: "over a year", args: args{to: from.AddDate(1, 5, 0)}, want: "over a year"},
+		{name: "almost 2 years", args: args{to: from.AddDate(1, 10, 0)}, want: "almost 2 years"},
+		{name: "over floor", args: args{to: from.AddDate(1, 10, 0), opts: DistanceOptions{Rounding: RoundFloor}}, want: "over a year"},
+		{name: "almost ceil", args: args{to: from.AddDate(1, 5, 0), opts: DistanceOptions{Rounding: RoundCeil}}, want: "almost 2 years"},
+		{name: "about years", args: args{to: from.AddDate(5, 0, 3)}, want: "about 5 years"},
+		{name: "backwards", args: args{to: from.AddDate(-1, -10, 0)}, want: "almost 2 years"},
+		{name: "russian", args: args{to: from.AddDate(1, 5, 0), opts: DistanceOptions{Locale: Russian}}, want: "год с лишним"},
+		{name: "russian hours", args: args{to: from.Add(150 * time.Minute), opts: DistanceOptions{Locale: Russian}}, want: "примерно 3 часа"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, DistanceInWords(from, tt.args.to, tt.args.opts))
+		})
+	}
+}
+
+func TestTimeAgoInWords(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	type args struct {
+		t    time.Time
+		opts DistanceOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{name: "past", args: args{t: now.AddDate(0, -3, 0)}, want: "about 3 months ago"},
+		{name: "future", args: args{t: now.AddDate(1, 10, 0)}, want: "in almost 2 years"},
+		{name: "now", args: args{t: now}, want: "less than a minute ago"},
+		{name: "russian past", args: args{t: now.Add(-time.Minute), opts: DistanceOptions{Locale: Russian}}, want: "минуту назад"},
+		{name: "russian months", args: args{t: now.AddDate(0, 0, -40), opts: DistanceOptions{Locale: Russian}}, want: "примерно месяц назад"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, TimeAgoInWords(tt.args.t, now, tt.args.opts))
+		})
+	}
+}
@@ -0,0 +1,309 @@
+package dates
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// DurationStyle describes how HumanizeDuration renders a duration.
+type DurationStyle int8
+
+const (
+	// DurationLong renders full unit names, e.g. "2 hours 5 minutes"
+	DurationLong DurationStyle = iota + 1
+	// DurationShort renders abbreviated units, e.g. "2h 5m"
+	DurationShort
+	// DurationApprox renders the largest unit only, e.g. "about 2 hours"
+	DurationApprox
+)
+
+// Rounding describes how a value is rounded to the smallest displayed unit.
+type Rounding int8
+
+const (
+	// RoundNearest rounds half away from zero. It is the default.
+	RoundNearest Rounding = iota + 1
+	// RoundFloor truncates the value
+	RoundFloor
+	// RoundCeil rounds the value up
+	RoundCeil
+)
+
+// ErrInvalidDuration is returned when a human-readable duration can not be parsed.
+var ErrInvalidDuration = errors.New("dates: invalid duration")
+
+// DurationOptions configures HumanizeDuration.
+// The zero value renders every unit from days to seconds in English.
+type DurationOptions struct {
+	// Style is the rendering style, DurationLong by default.
+	Style DurationStyle
+	// Largest is the largest unit to use.
+	// Defaults to Day, or to Year for the DurationApprox style.
+	Largest Unit
+	// Smallest is the smallest unit to use, Second by default.
+	Smallest Unit
+	// Precision limits the number of units shown.
+	// Zero shows all of them, or one for the DurationApprox style.
+	Precision int
+	// Rounding is applied to the smallest shown unit, RoundNearest by default.
+	Rounding Rounding
+	// Locale provides unit names, English by default.
+	Locale *Locale
+}
+
+// HumanizeDuration returns a human-readable representation of the duration.
+// Months and years are counted as 30 and 365 days.
+func HumanizeDuration(d time.Duration, opts DurationOptions) string {
+	loc := opts.Locale.orDefault()
+	if opts.Style == 0 {
+		opts.Style = DurationLong
+	}
+	if opts.Largest == 0 {
+		opts.Largest = Day
+		if opts.Style == DurationApprox {
+			opts.Largest = Year
+		}
+	}
+	if opts.Smallest == 0 {
+		opts.Smallest = Second
+	}
+	opts.Largest, opts.Smallest = clampUnit(opts.Largest), clampUnit(opts.Smallest)
+	if opts.Smallest > opts.Largest {
+		opts.Smallest = opts.Largest
+	}
+	if opts.Precision <= 0 && opts.Style == DurationApprox {
+		opts.Precision = 1
+	}
+
+	sign := ""
+	switch {
+	case d == math.MinInt64:
+		sign, d = "-", math.MaxInt64
+	case d < 0:
+		sign, d = "-", -d
+	}
+
+	shown := unitsBetween(opts.Largest, opts.Smallest)
+	lead := leadingUnit(d, shown)
+	last := len(shown) - 1
+	if opts.Precision > 0 && lead+opts.Precision-1 < last {
+		last = lead + opts.Precision - 1
+	}
+
+	rounded := roundDuration(d, shown[last].Duration(), opts.Rounding)
+	if lead = leadingUnit(rounded, shown); lead > last {
+		lead = last
+	}
+
+	parts := make([]string, 0, last-lead+1)
+	rem := rounded
+	for _, u := range shown[lead : last+1] {
+		n := int(rem / u.Duration())
+		rem -= time.Duration(n) * u.Duration()
+		if n == 0 {
+			continue
+		}
+		parts = append(parts, formatUnit(n, u, opts.Style, loc))
+	}
+	if len(parts) == 0 {
+		parts = append(parts, formatUnit(0, shown[last], opts.Style, loc))
+	}
+
+	s := sign + strings.Join(parts, " ")
+	if opts.Style == DurationApprox && rounded != d && loc.About != "" {
+		s = loc.About + " " + s
+	}
+
+	return s
+}
+
+// ParseHumanDuration parses durations like "1 day 3 hours", "2h 5m" or "1.5 weeks".
+// Besides the units of time.ParseDuration it accepts days, weeks, months and years
+// named in any of the built-in locales. Months and years are counted as 30 and 365 days.
+func ParseHumanDuration(s string) (time.Duration, error) {
+	in := []rune(strings.ToLower(strings.TrimSpace(s)))
+	if len(in) == 0 {
+		return 0, fmt.Errorf("%w: empty string", ErrInvalidDuration)
+	}
+
+	neg := false
+	if in[0] == '-' || in[0] == '+' {
+		neg = in[0] == '-'
+		in = in[1:]
+	}
+
+	var (
+		total float64
+		found bool
+		// conj tells that a conjunction waits for the next part.
+		conj bool
+	)
+	for i := 0; i < len(in); {
+		if unicode.IsSpace(in[i]) || in[i] == ',' {
+			i++
+			continue
+		}
+
+		start := i
+		for i < len(in) && (unicode.IsDigit(in[i]) || in[i] == '.') {
+			i++
+		}
+		if start == i {
+			word := readWord(in, &i)
+			if _, ok := durationConjunctions[word]; ok && found && !conj {
+				conj = true
+				continue
+			}
+			return 0, fmt.Errorf("%w: expected number at %q", ErrInvalidDuration, string(in[start:]))
+		}
+		n, err := strconv.ParseFloat(string(in[start:i]), 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: bad number %q", ErrInvalidDuration, string(in[start:i]))
+		}
+
+		for i < len(in) && unicode.IsSpace(in[i]) {
+			i++
+		}
+		word := readWord(in, &i)
+		unit, ok := durationUnits[word]
+		if !ok {
+			return 0, fmt.Errorf("%w: unknown unit %q", ErrInvalidDuration, word)
+		}
+
+		total += n * float64(unit)
+		found, conj = true, false
+	}
+
+	if conj {
+		return 0, fmt.Errorf("%w: %q ends with a conjunction", ErrInvalidDuration, s)
+	}
+	// float64(math.MaxInt64) rounds up to 1<<63, which does not fit.
+	if total >= 1<<63 {
+		return 0, fmt.Errorf("%w: %q overflows", ErrInvalidDuration, s)
+	}
+	if neg {
+		total = -total
+	}
+
+	return time.Duration(total), nil
+}
+
+// clampUnit limits the unit to the range from Second to Year.
+func clampUnit(u Unit) Unit {
+	return min(max(u, Second), Year)
+}
+
+// Duration returns the length of the unit.
+// Months and years are counted as 30 and 365 days.
+func (u Unit) Duration() time.Duration {
+	switch u {
+	case Second:
+		return time.Second
+	case Minute:
+		return time.Minute
+	case Hour:
+		return time.Hour
+	case Day:
+		return 24 * time.Hour
+	case Week:
+		return 7 * 24 * time.Hour
+	case Month:
+		return 30 * 24 * time.Hour
+	case Year:
+		return 365 * 24 * time.Hour
+	}
+	return 0
+}
+
+// durationConjunctions are words allowed between parts of a duration.
+var durationConjunctions = map[string]struct{}{"and": {}, "и": {}}
+
+// durationUnits maps unit names of all built-in locales to their lengths.
+var durationUnits = func() map[string]time.Duration {
+	m := map[string]time.Duration{
+		"ns":   time.Nanosecond,
+		"us":   time.Microsecond,
+		"µs":   time.Microsecond,
+		"μs":   time.Microsecond,
+		"ms":   time.Millisecond,
+		"sec":  time.Second,
+		"secs": time.Second,
+		"min":  time.Minute,
+		"mins": time.Minute,
+		"hr":   time.Hour,
+		"hrs":  time.Hour,
+	}
+	for _, l := range locales {
+		for u, forms := range l.Units {
+			for _, f := range forms {
+				m[strings.ToLower(f)] = u.Duration()
+			}
+		}
+		for u, f := range l.ShortUnits {
+			m[strings.ToLower(f)] = u.Duration()
+		}
+	}
+	return m
+}()
+
+// readWord reads letters starting at i and moves i past them.
+func readWord(in []rune, i *int) string {
+	start := *i
+	for *i < len(in) && unicode.IsLetter(in[*i]) {
+		*i++
+	}
+	return string(in[start:*i])
+}
+
+// unitsBetween returns units from largest to smallest inclusive.
+func unitsBetween(largest, smallest Unit) []Unit {
+	uu := make([]Unit, 0, len(units))
+	for _, u := range units {
+		if u <= largest && u >= smallest {
+			uu = append(uu, u)
+		}
+	}
+	return uu
+}
+
+// leadingUnit returns the index of the first unit that fits into d.
+func leadingUnit(d time.Duration, uu []Unit) int {
+	for i, u := range uu {
+		if d >= u.Duration() {
+			return i
+		}
+	}
+	return len(uu) - 1
+}
+
+// roundDuration rounds d to a multiple of m.
+// It rounds toward zero when rounding up would overflow.
+func roundDuration(d, m time.Duration, r Rounding) time.Duration {
+	rem := d % m
+	if rem == 0 || d-rem > math.MaxInt64-m {
+		return d - rem
+	}
+	switch r {
+	case RoundFloor:
+		return d - rem
+	case RoundCeil:
+		return d - rem + m
+	}
+	if rem*2 >= m {
+		return d - rem + m
+	}
+	return d - rem
+}
+
+// formatUnit renders n units in the given style.
+func formatUnit(n int, u Unit, style DurationStyle, loc *Locale) string {
+	if style == DurationShort {
+		return strconv.Itoa(n) + loc.ShortUnitSep + loc.ShortUnits[u]
+	}
+	return strconv.Itoa(n) + " " + loc.unit(u, n)
+}
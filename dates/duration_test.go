@@ -0,0 +1,174 @@
+package dates
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHumanizeDuration(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		d    time.Duration
+		opts DurationOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "zero",
+			args: args{d: 0},
+			want: "0 seconds",
+		},
+		{
+			name: "largest out of range",
+			args: args{d: 2*time.Hour + 5*time.Minute, opts: DurationOptions{Largest: -1}},
+			want: "7500 seconds",
+		},
+		{
+			name: "smallest out of range",
+			args: args{d: 2*time.Hour + 5*time.Minute, opts: DurationOptions{Smallest: 100}},
+			want: "0 days",
+		},
+		{
+			name: "max duration",
+			args: args{d: math.MaxInt64},
+			want: "106751 days 23 hours 47 minutes 16 seconds",
+		},
+		{
+			name: "min duration",
+			args: args{d: math.MinInt64},
+			want: "-106751 days 23 hours 47 minutes 16 seconds",
+		},
+		{
+			name: "max duration in seconds rounded up",
+			args: args{d: math.MaxInt64, opts: DurationOptions{Largest: Second, Rounding: RoundCeil}},
+			want: "9223372036 seconds",
+		},
+		{
+			name: "long",
+			args: args{d: 2*time.Hour + 5*time.Minute},
+			want: "2 hours 5 minutes",
+		},
+		{
+			name: "long singular",
+			args: args{d: 24*time.Hour + time.Hour + time.Second},
+			want: "1 day 1 hour 1 second",
+		},
+		{
+			name: "short",
+			args: args{d: 2*time.Hour + 5*time.Minute, opts: DurationOptions{Style: DurationShort}},
+			want: "2h 5m",
+		},
+		{
+			name: "approximate",
+			args: args{d: 2*time.Hour + 5*time.Minute, opts: DurationOptions{Style: DurationApprox}},
+			want: "about 2 hours",
+		},
+		{
+			name: "approximate exact",
+			args: args{d: 3 * time.Hour, opts: DurationOptions{Style: DurationApprox}},
+			want: "3 hours",
+		},
+		{
+			name: "approximate rounds up to the next unit",
+			args: args{d: 23*time.Hour + 40*time.Minute, opts: DurationOptions{Style: DurationApprox}},
+			want: "about 1 day",
+		},
+		{
+			name: "precision with nearest rounding",
+			args: args{d: time.Hour + 29*time.Minute + 31*time.Second, opts: DurationOptions{Precision: 2}},
+			want: "1 hour 30 minutes",
+		},
+		{
+			name: "precision with floor rounding",
+			args: args{d: time.Hour + 29*time.Minute + 31*time.Second, opts: DurationOptions{Precision: 2, Rounding: RoundFloor}},
+			want: "1 hour 29 minutes",
+		},
+		{
+			name: "ceil rounding",
+			args: args{d: time.Hour + time.Second, opts: DurationOptions{Smallest: Hour, Rounding: RoundCeil}},
+			want: "2 hours",
+		},
+		{
+			name: "smallest unit",
+			args: args{d: 30 * time.Second, opts: DurationOptions{Smallest: Minute, Rounding: RoundFloor}},
+			want: "0 minutes",
+		},
+		{
+			name: "weeks",
+			args: args{d: 10 * 24 * time.Hour, opts: DurationOptions{Largest: Week}},
+			want: "1 week 3 days",
+		},
+		{
+			name: "negative",
+			args: args{d: -90 * time.Second, opts: DurationOptions{Style: DurationShort}},
+			want: "-1m 30s",
+		},
+		{
+			name: "russian",
+			args: args{d: 22*time.Hour + 5*time.Minute, opts: DurationOptions{Locale: Russian}},
+			want: "22 часа 5 минут",
+		},
+		{
+			name: "russian short",
+			args: args{d: 2*time.Hour + 11*time.Minute, opts: DurationOptions{Style: DurationShort, Locale: Russian}},
+			want: "2 ч 11 мин",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, HumanizeDuration(tt.args.d, tt.args.opts))
+		})
+	}
+}
+
+func TestParseHumanDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s       string
+		want    time.Duration
+		wantErr bool
+	}{
+		{s: "1 day 3 hours", want: 27 * time.Hour},
+		{s: "2h 5m", want: 2*time.Hour + 5*time.Minute},
+		{s: "1h30m15s", want: time.Hour + 30*time.Minute + 15*time.Second},
+		{s: "1.5 weeks", want: 252 * time.Hour},
+		{s: "2 weeks, 1 day and 30 minutes", want: 15*24*time.Hour + 30*time.Minute},
+		{s: "-3 Days", want: -72 * time.Hour},
+		{s: "1 year", want: 365 * 24 * time.Hour},
+		{s: "250ms", want: 250 * time.Millisecond},
+		{s: "2 дня 5 часов", want: 53 * time.Hour},
+		{s: "", wantErr: true},
+		{s: "hours", wantErr: true},
+		{s: "5 parsecs", wantErr: true},
+		{s: "1..5 days", wantErr: true},
+		{s: "and 5 days", wantErr: true},
+		{s: "1 day and", wantErr: true},
+		{s: "1 day and and 2 hours", wantErr: true},
+		{s: "9223372036854775807ns", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseHumanDuration(tt.s)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidDuration)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
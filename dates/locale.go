@@ -0,0 +1,365 @@
+package dates
+
+import (
+	"strconv"
+	"time"
+)
+
+// Unit describes a calendar or clock unit used by the formatters.
+type Unit int8
+
+const (
+	// Second describes the second unit
+	Second Unit = iota + 1
+	// Minute describes the minute unit
+	Minute
+	// Hour describes the hour unit
+	Hour
+	// Day describes the day unit
+	Day
+	// Week describes the week unit
+	Week
+	// Month describes the month unit
+	Month
+	// Year describes the year unit
+	Year
+)
+
+// units lists all units from the largest to the smallest.
+var units = []Unit{Year, Month, Week, Day, Hour, Minute, Second}
+
+// Locale holds the words and the rules formatters use to build
+// text in a particular language.
+type Locale struct {
+	// Tag is the language tag, e.g. "en" or "ru".
+	Tag string
+	// Months are month names used inside dates, starting from January.
+	Months [12]string
+	// StandaloneMonths are month names used on their own, e.g. in "LLLL".
+	// Falls back to Months when empty.
+	StandaloneMonths [12]string
+	// ShortMonths are abbreviated month names, starting from January.
+	ShortMonths [12]string
+	// Weekdays are weekday names, starting from Sunday.
+	Weekdays [7]string
+	// ShortWeekdays are abbreviated weekday names, starting from Sunday.
+	ShortWeekdays [7]string
+	// DayPeriods are the AM and PM markers.
+	DayPeriods [2]string
+	// Quarters are quarter names, e.g. "1st quarter".
+	Quarters [4]string
+	// ShortQuarters are abbreviated quarter names, e.g. "Q1".
+	ShortQuarters [4]string
+	// Units holds the plural forms of each unit in the order
+	// the Plural function indexes them.
+	Units map[Unit][]string
+	// ShortUnits holds the abbreviation of each unit.
+	ShortUnits map[Unit]string
+	// ShortUnitSep separates a number from its abbreviated unit.
+	ShortUnitSep string
+	// NarrowUnits holds the narrowest abbreviation of each unit
+	// written right after a number. Falls back to ShortUnits.
+	NarrowUnits map[Unit]string
+	// Now describes the current moment.
+	Now string
+	// YourTime marks a time converted to the reader's location.
+	YourTime string
+	// Today, Yesterday, ThisWeek and Earlier label groups of a timeline.
+	Today, Yesterday, ThisWeek, Earlier string
+	// About prefixes approximate values.
+	About string
+	// Over and Almost are fmt formats of values slightly above and below
+	// the given one, e.g. "over %s".
+	Over, Almost string
+	// Ago and In are fmt formats of past and future distances, e.g. "%s ago".
+	Ago, In string
+	// LessThanMinute describes a distance shorter than a minute.
+	LessThanMinute string
+	// Singles holds the words for one unit used in phrases,
+	// e.g. "an hour" in "about an hour ago".
+	Singles map[Unit]string
+	// BusinessUnits holds the plural forms of business days, hours and
+	// minutes as used in the Ago and In formats. Falls back to English.
+	BusinessUnits map[Unit][]string
+	// Plural returns the index of the plural form to use for n.
+	// Falls back to the English rule.
+	Plural func(n int) int
+	// Ordinal returns the ordinal numeral for n as used in dates.
+	// Falls back to the English numerals.
+	Ordinal func(n int) string
+	// OrdinalDay is the fmt format of a day of month referred to
+	// on its own, e.g. "the %s".
+	OrdinalDay string
+}
+
+// English is the default locale.
+var English = &Locale{
+	Tag: "en",
+	Months: [12]string{
+		"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December",
+	},
+	ShortMonths: [12]string{
+		"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec",
+	},
+	Weekdays: [7]string{
+		"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+	},
+	ShortWeekdays: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	DayPeriods:    [2]string{"AM", "PM"},
+	Quarters:      [4]string{"1st quarter", "2nd quarter", "3rd quarter", "4th quarter"},
+	ShortQuarters: [4]string{"Q1", "Q2", "Q3", "Q4"},
+	Units: map[Unit][]string{
+		Second: {"second", "seconds"},
+		Minute: {"minute", "minutes"},
+		Hour:   {"hour", "hours"},
+		Day:    {"day", "days"},
+		Week:   {"week", "weeks"},
+		Month:  {"month", "months"},
+		Year:   {"year", "years"},
+	},
+	ShortUnits: map[Unit]string{
+		Second: "s",
+		Minute: "m",
+		Hour:   "h",
+		Day:    "d",
+		Week:   "w",
+		Month:  "mo",
+		Year:   "y",
+	},
+	NarrowUnits: map[Unit]string{
+		Second: "s",
+		Minute: "m",
+		Hour:   "h",
+		Day:    "d",
+		Week:   "w",
+		Month:  "mo",
+		Year:   "y",
+	},
+	About:          "about",
+	Over:           "over %s",
+	Almost:         "almost %s",
+	Ago:            "%s ago",
+	In:             "in %s",
+	LessThanMinute: "less than a minute",
+	Singles: map[Unit]string{
+		Second: "a second",
+		Minute: "a minute",
+		Hour:   "an hour",
+		Day:    "a day",
+		Week:   "a week",
+		Month:  "a month",
+		Year:   "a year",
+	},
+	BusinessUnits: map[Unit][]string{
+		Minute: {"business minute", "business minutes"},
+		Hour:   {"business hour", "business hours"},
+		Day:    {"business day", "business days"},
+	},
+	Now:       "now",
+	YourTime:  "your time",
+	Today:     "Today",
+	Yesterday: "Yesterday",
+	ThisWeek:  "This week",
+	Earlier:   "Earlier",
+	Plural: func(n int) int {
+		if n == 1 {
+			return 0
+		}
+		return 1
+	},
+	Ordinal: func(n int) string {
+		abs := n
+		if abs < 0 {
+			abs = -abs
+		}
+		suffix := "th"
+		switch {
+		case abs%100 >= 11 && abs%100 <= 13:
+		case abs%10 == 1:
+			suffix = "st"
+		case abs%10 == 2:
+			suffix = "nd"
+		case abs%10 == 3:
+			suffix = "rd"
+		}
+		return strconv.Itoa(n) + suffix
+	},
+	OrdinalDay: "the %s",
+}
+
+// Russian is the Russian locale.
+var Russian = &Locale{
+	Tag: "ru",
+	Months: [12]string{
+		"января", "февраля", "марта", "апреля", "мая", "июня",
+		"июля", "августа", "сентября", "октября", "ноября", "декабря",
+	},
+	StandaloneMonths: [12]string{
+		"январь", "февраль", "март", "апрель", "май", "июнь",
+		"июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь",
+	},
+	ShortMonths: [12]string{
+		"янв", "фев", "мар", "апр", "мая", "июн", "июл", "авг", "сен", "окт", "ноя", "дек",
+	},
+	Weekdays: [7]string{
+		"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота",
+	},
+	ShortWeekdays: [7]string{"вс", "пн", "вт", "ср", "чт", "пт", "сб"},
+	DayPeriods:    [2]string{"AM", "PM"},
+	Quarters:      [4]string{"1-й квартал", "2-й квартал", "3-й квартал", "4-й квартал"},
+	ShortQuarters: [4]string{"1-й кв.", "2-й кв.", "3-й кв.", "4-й кв."},
+	Units: map[Unit][]string{
+		Second: {"секунда", "секунды", "секунд"},
+		Minute: {"минута", "минуты", "минут"},
+		Hour:   {"час", "часа", "часов"},
+		Day:    {"день", "дня", "дней"},
+		Week:   {"неделя", "недели", "недель"},
+		Month:  {"месяц", "месяца", "месяцев"},
+		Year:   {"год", "года", "лет"},
+	},
+	ShortUnits: map[Unit]string{
+		Second: "с",
+		Minute: "мин",
+		Hour:   "ч",
+		Day:    "д",
+		Week:   "нед",
+		Month:  "мес",
+		Year:   "г",
+	},
+	ShortUnitSep: " ",
+	NarrowUnits: map[Unit]string{
+		Second: "с",
+		Minute: "м",
+		Hour:   "ч",
+		Day:    "д",
+		Week:   "н",
+		Month:  "мс",
+		Year:   "г",
+	},
+	About:          "примерно",
+	Over:           "%s с лишним",
+	Almost:         "почти %s",
+	Ago:            "%s назад",
+	In:             "через %s",
+	LessThanMinute: "меньше минуты",
+	// Accusative forms agreeing with "назад" and "через".
+	Singles: map[Unit]string{
+		Second: "секунду",
+		Minute: "минуту",
+		Hour:   "час",
+		Day:    "день",
+		Week:   "неделю",
+		Month:  "месяц",
+		Year:   "год",
+	},
+	// Accusative forms agreeing with "назад" and "через".
+	BusinessUnits: map[Unit][]string{
+		Minute: {"рабочую минуту", "рабочие минуты", "рабочих минут"},
+		Hour:   {"рабочий час", "рабочих часа", "рабочих часов"},
+		Day:    {"рабочий день", "рабочих дня", "рабочих дней"},
+	},
+	Now:       "сейчас",
+	YourTime:  "по вашему времени",
+	Today:     "Сегодня",
+	Yesterday: "Вчера",
+	ThisWeek:  "На этой неделе",
+	Earlier:   "Ранее",
+	Plural: func(n int) int {
+		if n < 0 {
+			n = -n
+		}
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return 0
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return 1
+		}
+		return 2
+	},
+	// Dates use the neuter form agreeing with "число".
+	Ordinal: func(n int) string {
+		return strconv.Itoa(n) + "-е"
+	},
+	OrdinalDay: "%s число",
+}
+
+// locales lists the built-in locales.
+var locales = []*Locale{English, Russian}
+
+// orDefault returns the locale itself or English if it is nil.
+func (l *Locale) orDefault() *Locale {
+	if l == nil {
+		return English
+	}
+	return l
+}
+
+// unit returns the plural form of the unit for n.
+func (l *Locale) unit(u Unit, n int) string {
+	forms := l.Units[u]
+	if len(forms) == 0 {
+		return ""
+	}
+	i := l.plural(n)
+	if i >= len(forms) {
+		i = len(forms) - 1
+	}
+	return forms[i]
+}
+
+// businessUnit returns the plural form of the business unit for n.
+func (l *Locale) businessUnit(u Unit, n int) string {
+	forms := l.BusinessUnits[u]
+	if len(forms) == 0 {
+		l, forms = English, English.BusinessUnits[u]
+	}
+	i := l.plural(n)
+	if i >= len(forms) {
+		i = len(forms) - 1
+	}
+	return forms[i]
+}
+
+// plural returns the index of the plural form for n.
+func (l *Locale) plural(n int) int {
+	if l.Plural == nil {
+		return English.Plural(n)
+	}
+	return l.Plural(n)
+}
+
+// ordinal returns the ordinal numeral for n.
+func (l *Locale) ordinal(n int) string {
+	if l.Ordinal == nil {
+		return English.Ordinal(n)
+	}
+	return l.Ordinal(n)
+}
+
+// month returns the name of the month, abbreviated if short is true.
+func (l *Locale) month(m time.Month, short, standalone bool) string {
+	switch {
+	case short:
+		return l.ShortMonths[m-1]
+	case standalone && l.StandaloneMonths[m-1] != "":
+		return l.StandaloneMonths[m-1]
+	}
+	return l.Months[m-1]
+}
+
+// weekday returns the name of the weekday, abbreviated if short is true.
+func (l *Locale) weekday(d time.Weekday, short bool) string {
+	if short {
+		return l.ShortWeekdays[d]
+	}
+	return l.Weekdays[d]
+}
+
+// dayPeriod returns the AM or PM marker for the hour.
+func (l *Locale) dayPeriod(hour int) string {
+	if hour < 12 {
+		return l.DayPeriods[0]
+	}
+	return l.DayPeriods[1]
+}
@@ -0,0 +1,67 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocale_Partial(t *testing.T) {
+	t.Parallel()
+
+	dutch := &Locale{
+		Tag: "nl",
+		Months: [12]string{
+			"januari", "februari", "maart", "april", "mei", "juni",
+			"juli", "augustus", "september", "oktober", "november", "december",
+		},
+		Units: map[Unit][]string{
+			Minute: {"minuut", "minuten"},
+			Hour:   {"uur", "uur"},
+		},
+		About:      "ongeveer",
+		Ago:        "%s geleden",
+		OrdinalDay: "de %s",
+	}
+	tm := time.Date(2026, 3, 3, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		got  func() string
+		want string
+	}{
+		{
+			name: "Ordinal",
+			got:  func() string { return Ordinal(3, dutch) },
+			want: "3rd",
+		},
+		{
+			name: "OrdinalDay",
+			got:  func() string { return OrdinalDay(tm, dutch) },
+			want: "de 3rd",
+		},
+		{
+			name: "HumanizeDuration",
+			got: func() string {
+				return HumanizeDuration(2*time.Hour+time.Minute, DurationOptions{Locale: dutch})
+			},
+			want: "2 uur 1 minuut",
+		},
+		{
+			name: "TimeAgoInWords",
+			got: func() string {
+				return TimeAgoInWords(tm.Add(-5*time.Minute), tm, DistanceOptions{Locale: dutch})
+			},
+			want: "5 minuten geleden",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, tt.got())
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package dates
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Ordinal returns the ordinal numeral for n in the locale, English if nil,
+// e.g. "1st", "22nd" or "3-е".
+func Ordinal(n int, locale *Locale) string {
+	return locale.orDefault().ordinal(n)
+}
+
+// OrdinalDay returns the day of month referred to on its own, e.g. "the 21st".
+func OrdinalDay(t time.Time, locale *Locale) string {
+	loc := locale.orDefault()
+	return fmt.Sprintf(loc.OrdinalDay, loc.ordinal(t.Day()))
+}
+
+// LongDMY returns a long date string in the format "day month year", e.g. "3 March 2026".
+func LongDMY(t time.Time, locale *Locale) string {
+	return FormatCLDR(t, "d MMMM y", locale)
+}
+
+// LongMDY returns a long date string in the format "month ordinal day, year",
+// e.g. "March 3rd, 2026".
+func LongMDY(t time.Time, locale *Locale) string {
+	loc := locale.orDefault()
+	return loc.month(t.Month(), false, false) + " " + loc.ordinal(t.Day()) + ", " + strconv.Itoa(t.Year())
+}
+
+// WeekdayDM returns a long date string in the format "weekday, day month",
+// e.g. "Tuesday, 3 March".
+func WeekdayDM(t time.Time, locale *Locale) string {
+	return FormatCLDR(t, "EEEE, d MMMM", locale)
+}
+
+// WeekdayDMY returns a long date string in the format "weekday, day month year",
+// e.g. "Tuesday, 3 March 2026".
+func WeekdayDMY(t time.Time, locale *Locale) string {
+	return FormatCLDR(t, "EEEE, d MMMM y", locale)
+}
+
+// WeekdayMDY returns a long date string in the format "weekday, month ordinal day, year",
+// e.g. "Tuesday, March 3rd, 2026".
+func WeekdayMDY(t time.Time, locale *Locale) string {
+	return locale.orDefault().weekday(t.Weekday(), false) + ", " + LongMDY(t, locale)
+}
@@ -0,0 +1,73 @@
+package dates
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrdinal(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		n      int
+		locale *Locale
+	}
+	tests := []struct {
+		args args
+		want string
+	}{
+		{args: args{n: 1}, want: "1st"},
+		{args: args{n: 2}, want: "2nd"},
+		{args: args{n: 3}, want: "3rd"},
+		{args: args{n: 4}, want: "4th"},
+		{args: args{n: 11}, want: "11th"},
+		{args: args{n: 12}, want: "12th"},
+		{args: args{n: 13}, want: "13th"},
+		{args: args{n: 21}, want: "21st"},
+		{args: args{n: 102}, want: "102nd"},
+		{args: args{n: 111}, want: "111th"},
+		{args: args{n: 0}, want: "0th"},
+		{args: args{n: -1}, want: "-1st"},
+		{args: args{n: 3, locale: Russian}, want: "3-е"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(strconv.Itoa(tt.args.n), func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, Ordinal(tt.args.n, tt.args.locale))
+		})
+	}
+}
+
+func TestLongFormats(t *testing.T) {
+	t.Parallel()
+
+	tm := time.Date(2026, 3, 3, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "LongDMY", got: LongDMY(tm, nil), want: "3 March 2026"},
+		{name: "LongDMY russian", got: LongDMY(tm, Russian), want: "3 марта 2026"},
+		{name: "LongMDY", got: LongMDY(tm, nil), want: "March 3rd, 2026"},
+		{name: "WeekdayDM", got: WeekdayDM(tm, nil), want: "Tuesday, 3 March"},
+		{name: "WeekdayDM russian", got: WeekdayDM(tm, Russian), want: "вторник, 3 марта"},
+		{name: "WeekdayDMY", got: WeekdayDMY(tm, nil), want: "Tuesday, 3 March 2026"},
+		{name: "WeekdayMDY", got: WeekdayMDY(tm, nil), want: "Tuesday, March 3rd, 2026"},
+		{name: "OrdinalDay", got: OrdinalDay(tm.AddDate(0, 0, 18), nil), want: "the 21st"},
+		{name: "OrdinalDay russian", got: OrdinalDay(tm.AddDate(0, 0, 18), Russian), want: "21-е число"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, tt.got)
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package dates
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ParseError describes where and why a date string could not be parsed.
+// It wraps ErrInvalidDate.
+type ParseError struct {
+	// Input is the parsed string.
+	Input string
+	// Pos is the byte offset in Input where the error was found.
+	Pos int
+	// Msg describes what was expected.
+	Msg string
+}
+
+// Error returns the error message.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("dates: parse %q at position %d: %s", e.Input, e.Pos, e.Msg)
+}
+
+// Unwrap returns ErrInvalidDate.
+func (e *ParseError) Unwrap() error {
+	return ErrInvalidDate
+}
+
+// ParseShortDMY strictly parses strings in the format of ShortDMY, e.g. "3 Mar 2026",
+// with month names of the locale, English if nil. The result is the midnight
+// of the day in loc, UTC if nil.
+func ParseShortDMY(s string, locale *Locale, loc *time.Location) (time.Time, error) {
+	p := shortParser{s: s, locale: locale.orDefault()}
+	day := p.number("day", 1, 31, false)
+	p.space()
+	month := p.month()
+	p.space()
+	year := p.year()
+	p.end()
+	if p.err == nil && day > daysIn(year, month) {
+		p.fail(0, fmt.Sprintf("day %d out of range for %s %d", day, month, year))
+	}
+	if p.err != nil {
+		return time.Time{}, p.err
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, locationOrUTC(loc)), nil
+}
+
+// ParseShortMY strictly parses strings in the format of ShortMY, e.g. "Mar 2026",
+// with month names of the locale, English if nil. The result is the midnight
+// of the first day of the month in loc, UTC if nil.
+func ParseShortMY(s string, locale *Locale, loc *time.Location) (time.Time, error) {
+	p := shortParser{s: s, locale: locale.orDefault()}
+	month := p.month()
+	p.space()
+	year := p.year()
+	p.end()
+	if p.err != nil {
+		return time.Time{}, p.err
+	}
+	return time.Date(year, month, 1, 0, 0, 0, 0, locationOrUTC(loc)), nil
+}
+
+// ParseShortMYHM strictly parses strings in the format of ShortMYHM, e.g. "Mar 2026 14:30",
+// with month names of the locale, English if nil. The result is the given time
+// of the first day of the month in loc, UTC if nil.
+func ParseShortMYHM(s string, locale *Locale, loc *time.Location) (time.Time, error) {
+	p := shortParser{s: s, locale: locale.orDefault()}
+	month := p.month()
+	p.space()
+	year := p.year()
+	p.space()
+	hour := p.number("hour", 0, 23, true)
+	p.literal(":")
+	minute := p.number("minute", 0, 59, true)
+	p.end()
+	if p.err != nil {
+		return time.Time{}, p.err
+	}
+	return time.Date(year, month, 1, hour, minute, 0, 0, locationOrUTC(loc)), nil
+}
+
+// shortParser reads the parts of short date strings keeping the first error.
+type shortParser struct {
+	s      string
+	pos    int
+	locale *Locale
+	err    *ParseError
+}
+
+// fail records the error at the position unless there is one already.
+func (p *shortParser) fail(pos int, msg string) {
+	if p.err == nil {
+		p.err = &ParseError{Input: p.s, Pos: pos, Msg: msg}
+	}
+}
+
+// number reads a decimal number in [min, max]. Two digits are required
+// if padded is true, otherwise leading zeros are rejected.
+func (p *shortParser) number(what string, min, max int, padded bool) int {
+	if p.err != nil {
+		return 0
+	}
+	start := p.pos
+	n := 0
+	for p.pos < len(p.s) && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' && p.pos-start < 9 {
+		n = n*10 + int(p.s[p.pos]-'0')
+		p.pos++
+	}
+	digits := p.pos - start
+
+	switch {
+	case digits == 0:
+		p.fail(start, "expected "+what)
+	case padded && digits != 2:
+		p.fail(start, "expected two-digit "+what)
+	case !padded && digits > 1 && p.s[start] == '0':
+		p.fail(start, "unexpected leading zero in "+what)
+	case n < min || n > max:
+		p.fail(start, fmt.Sprintf("%s %d out of range [%d, %d]", what, n, min, max))
+	}
+	return n
+}
+
+// year reads a year without leading zeros, possibly negative.
+func (p *shortParser) year() int {
+	if p.err != nil {
+		return 0
+	}
+	if strings.HasPrefix(p.s[p.pos:], "-") {
+		p.pos++
+		return -p.number("year", 1, 999999999, false)
+	}
+	return p.number("year", 0, 999999999, false)
+}
+
+// month reads an abbreviated month name of the locale.
+func (p *shortParser) month() time.Month {
+	if p.err != nil {
+		return 0
+	}
+	rest := p.s[p.pos:]
+	best := 0
+	for i, name := range p.locale.ShortMonths {
+		if name != "" && strings.HasPrefix(rest, name) && (best == 0 || len(name) > len(p.locale.ShortMonths[best-1])) {
+			best = i + 1
+		}
+	}
+	if best == 0 {
+		p.fail(p.pos, "expected month abbreviation")
+		return 0
+	}
+	p.pos += len(p.locale.ShortMonths[best-1])
+	return time.Month(best)
+}
+
+// space reads a single space.
+func (p *shortParser) space() {
+	p.literal(" ")
+}
+
+// literal reads the exact text.
+func (p *shortParser) literal(text string) {
+	if p.err != nil {
+		return
+	}
+	if !strings.HasPrefix(p.s[p.pos:], text) {
+		p.fail(p.pos, fmt.Sprintf("expected %q", text))
+		return
+	}
+	p.pos += len(text)
+}
+
+// end makes sure the whole input is read.
+func (p *shortParser) end() {
+	if p.err != nil || p.pos == len(p.s) {
+		return
+	}
+	r, _ := utf8.DecodeRuneInString(p.s[p.pos:])
+	p.fail(p.pos, fmt.Sprintf("unexpected %q", r))
+}
+
+// locationOrUTC returns loc or UTC if it is nil.
+func locationOrUTC(loc *time.Location) *time.Location {
+	if loc == nil {
+		return time.UTC
+	}
+	return loc
+}
@@ -0,0 +1,171 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseShortDMY(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s       string
+		locale  *Locale
+		want    time.Time
+		wantPos int
+		wantErr bool
+	}{
+		{s: "3 Mar 2026", want: time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{s: "31 Dec 2021", want: time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{s: "29 Feb 2024", want: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{s: "9 мая 2026", locale: Russian, want: time.Date(2026, 5, 9, 0, 0, 0, 0, time.UTC)},
+		{s: "", wantErr: true, wantPos: 0},
+		{s: "03 Mar 2026", wantErr: true, wantPos: 0},
+		{s: "3  Mar 2026", wantErr: true, wantPos: 2},
+		{s: "3 mar 2026", wantErr: true, wantPos: 2},
+		{s: "3 March 2026", wantErr: true, wantPos: 5},
+		{s: "32 Mar 2026", wantErr: true, wantPos: 0},
+		{s: "29 Feb 2026", wantErr: true, wantPos: 0},
+		{s: "3 Mar 02026", wantErr: true, wantPos: 6},
+		{s: "3 Mar 2026 ", wantErr: true, wantPos: 10},
+		{s: "3 Mar", wantErr: true, wantPos: 5},
+		{s: "3 Mar 2026", locale: Russian, wantErr: true, wantPos: 2},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseShortDMY(tt.s, tt.locale, nil)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidDate)
+
+				var perr *ParseError
+				require.True(t, errors.As(err, &perr))
+				require.Equal(t, tt.wantPos, perr.Pos)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseShortMY(t *testing.T) {
+	t.Parallel()
+
+	moscow := time.FixedZone("MSK", 3*3600)
+
+	got, err := ParseShortMY("Dec 2021", nil, moscow)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2021, 12, 1, 0, 0, 0, 0, moscow), got)
+
+	for _, s := range []string{"Dec", "Dec 2021 ", "Dex 2021", "1 Dec 2021"} {
+		_, err := ParseShortMY(s, nil, nil)
+		require.ErrorIs(t, err, ErrInvalidDate, s)
+	}
+}
+
+func TestParseShortMYHM(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseShortMYHM("Jan 2021 03:04", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2021, 1, 1, 3, 4, 0, 0, time.UTC), got)
+
+	tests := []struct {
+		s       string
+		wantPos int
+	}{
+		{s: "Jan 2021 3:04", wantPos: 9},
+		{s: "Jan 2021 24:00", wantPos: 9},
+		{s: "Jan 2021 23:60", wantPos: 12},
+		{s: "Jan 2021 23-59", wantPos: 11},
+		{s: "Jan 2021", wantPos: 8},
+	}
+	for _, tt := range tests {
+		_, err := ParseShortMYHM(tt.s, nil, nil)
+
+		var perr *ParseError
+		require.True(t, errors.As(err, &perr), tt.s)
+		require.Equal(t, tt.wantPos, perr.Pos, tt.s)
+		require.Equal(t, tt.s, perr.Input)
+	}
+}
+
+// fuzzTime maps arbitrary seconds to a time between years 1 and 9999.
+func fuzzTime(sec int64) time.Time {
+	const span = 315537897600 // seconds from year 1 to 10000
+	if sec < 0 {
+		sec = -(sec + 1)
+	}
+	return time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(sec%span) * time.Second)
+}
+
+// fuzzLocale picks a built-in locale.
+func fuzzLocale(ru bool) *Locale {
+	if ru {
+		return Russian
+	}
+	return English
+}
+
+func FuzzShortDMY(f *testing.F) {
+	f.Add(int64(0), false)
+	f.Add(int64(63907372800), true)
+	f.Add(int64(63881971200), false)
+	f.Fuzz(func(t *testing.T, sec int64, ru bool) {
+		tm, loc := fuzzTime(sec), fuzzLocale(ru)
+
+		got, err := ParseShortDMY(strftime(tm, "%-d %b %Y", loc), loc, nil)
+
+		require.NoError(t, err)
+		require.Equal(t, dateOf(tm), dateOf(got))
+	})
+}
+
+func FuzzShortMY(f *testing.F) {
+	f.Add(int64(0), false)
+	f.Add(int64(63907372800), true)
+	f.Fuzz(func(t *testing.T, sec int64, ru bool) {
+		tm, loc := fuzzTime(sec), fuzzLocale(ru)
+
+		got, err := ParseShortMY(strftime(tm, "%b %Y", loc), loc, nil)
+
+		require.NoError(t, err)
+		require.Equal(t, tm.Year(), got.Year())
+		require.Equal(t, tm.Month(), got.Month())
+	})
+}
+
+func FuzzShortMYHM(f *testing.F) {
+	f.Add(int64(0), false)
+	f.Add(int64(63907424999), true)
+	f.Fuzz(func(t *testing.T, sec int64, ru bool) {
+		tm, loc := fuzzTime(sec), fuzzLocale(ru)
+
+		got, err := ParseShortMYHM(strftime(tm, "%b %Y %H:%M", loc), loc, nil)
+
+		require.NoError(t, err)
+		require.Equal(t, tm.Truncate(time.Minute).AddDate(0, 0, 1-tm.Day()), got)
+	})
+}
+
+func FuzzParseShortDMY_Strict(f *testing.F) {
+	f.Add("3 Mar 2026")
+	f.Add("03 Mar 2026")
+	f.Add("31 Feb 2026")
+	f.Add("1 Jan -5")
+	f.Fuzz(func(t *testing.T, s string) {
+		got, err := ParseShortDMY(s, nil, nil)
+		if err != nil {
+			require.ErrorIs(t, err, ErrInvalidDate)
+			return
+		}
+
+		require.Equal(t, s, ShortDMY(got), "only canonical strings are accepted")
+	})
+}
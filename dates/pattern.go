@@ -0,0 +1,476 @@
+package dates
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ErrUnsupportedPattern is returned when a pattern can not be expressed as a Go layout.
+var ErrUnsupportedPattern = errors.New("dates: pattern has no Go layout equivalent")
+
+// Strftime formats the time using a C/Python strftime pattern like "%d %b %Y".
+// The "-" flag removes padding, e.g. "%-d". Unknown directives are kept as is.
+func Strftime(t time.Time, pattern string) string {
+	return strftime(t, pattern, English)
+}
+
+// FormatCLDR formats the time using a CLDR/Java pattern like "dd MMM yyyy"
+// with names from the locale, English if nil. Text in single quotes is kept
+// as is, while two single quotes stand for a quote.
+func FormatCLDR(t time.Time, pattern string, locale *Locale) string {
+	loc := locale.orDefault()
+	sb := strings.Builder{}
+	scanCLDR(pattern, func(field rune, n int, lit string) {
+		if field == 0 {
+			sb.WriteString(lit)
+			return
+		}
+		sb.WriteString(cldrField(t, field, n, loc))
+	})
+	return sb.String()
+}
+
+// StrftimeLayout converts a strftime pattern to a Go reference layout.
+func StrftimeLayout(pattern string) (string, error) {
+	sb := strings.Builder{}
+	var err error
+	scanStrftime(pattern, func(verb rune, nopad bool, lit string) {
+		if verb == 0 {
+			sb.WriteString(lit)
+			return
+		}
+		key := string(verb)
+		if nopad {
+			key = "-" + key
+		}
+		layout, ok := strftimeLayouts[key]
+		if verb == 'f' {
+			layout, ok = fractionLayout(sb.String(), 6)
+		}
+		if !ok && err == nil {
+			err = fmt.Errorf("%w: %%%s", ErrUnsupportedPattern, key)
+		}
+		sb.WriteString(layout)
+	})
+	if err != nil {
+		return "", err
+	}
+	return verifyLayout(sb.String(), func(t time.Time) string { return Strftime(t, pattern) })
+}
+
+// CLDRLayout converts a CLDR pattern to a Go reference layout.
+func CLDRLayout(pattern string) (string, error) {
+	sb := strings.Builder{}
+	var err error
+	scanCLDR(pattern, func(field rune, n int, lit string) {
+		if field == 0 {
+			sb.WriteString(lit)
+			return
+		}
+		key := strings.Repeat(string(field), n)
+		layout, ok := cldrLayouts[key]
+		if field == 'S' {
+			layout, ok = fractionLayout(sb.String(), n)
+		}
+		if !ok && err == nil {
+			err = fmt.Errorf("%w: %s", ErrUnsupportedPattern, key)
+		}
+		sb.WriteString(layout)
+	})
+	if err != nil {
+		return "", err
+	}
+	return verifyLayout(sb.String(), func(t time.Time) string { return FormatCLDR(t, pattern, English) })
+}
+
+// strftimeLayouts maps strftime directives to Go layout elements.
+var strftimeLayouts = map[string]string{
+	"a":  "Mon",
+	"A":  "Monday",
+	"b":  "Jan",
+	"h":  "Jan",
+	"B":  "January",
+	"c":  "Mon Jan _2 15:04:05 2006",
+	"d":  "02",
+	"-d": "2",
+	"e":  "_2",
+	"-e": "2",
+	"D":  "01/02/06",
+	"F":  "2006-01-02",
+	"H":  "15",
+	"I":  "03",
+	"-I": "3",
+	"j":  "002",
+	"m":  "01",
+	"-m": "1",
+	"M":  "04",
+	"-M": "4",
+	"p":  "PM",
+	"R":  "15:04",
+	"S":  "05",
+	"-S": "5",
+	"T":  "15:04:05",
+	"x":  "01/02/06",
+	"X":  "15:04:05",
+	"y":  "06",
+	"Y":  "2006",
+	"z":  "-0700",
+	"Z":  "MST",
+	"%":  "%",
+	"n":  "\n",
+	"t":  "\t",
+}
+
+// cldrLayouts maps CLDR fields to Go layout elements.
+var cldrLayouts = map[string]string{
+	"y":     "2006",
+	"yy":    "06",
+	"yyyy":  "2006",
+	"M":     "1",
+	"MM":    "01",
+	"MMM":   "Jan",
+	"MMMM":  "January",
+	"L":     "1",
+	"LL":    "01",
+	"LLL":   "Jan",
+	"LLLL":  "January",
+	"d":     "2",
+	"dd":    "02",
+	"DDD":   "002",
+	"E":     "Mon",
+	"EE":    "Mon",
+	"EEE":   "Mon",
+	"EEEE":  "Monday",
+	"a":     "PM",
+	"h":     "3",
+	"hh":    "03",
+	"HH":    "15",
+	"m":     "4",
+	"mm":    "04",
+	"s":     "5",
+	"ss":    "05",
+	"z":     "MST",
+	"zz":    "MST",
+	"zzz":   "MST",
+	"Z":     "-0700",
+	"ZZ":    "-0700",
+	"ZZZ":   "-0700",
+	"ZZZZZ": "Z07:00",
+	"X":     "Z07",
+	"XX":    "Z0700",
+	"XXX":   "Z07:00",
+	"x":     "-07",
+	"xx":    "-0700",
+	"xxx":   "-07:00",
+}
+
+// layoutProbes are the times converted layouts are checked against.
+var layoutProbes = []time.Time{
+	time.Date(2009, 11, 17, 20, 34, 58, 651387237, time.FixedZone("MSK", 3*60*60)),
+	time.Date(2023, 2, 3, 4, 5, 6, 7000000, time.FixedZone("CET", 60*60)),
+}
+
+// verifyLayout makes sure the layout formats the probes the same way the pattern does.
+// Go layouts have no escaping, so literal text like digits or month names may
+// be taken for layout elements.
+func verifyLayout(layout string, format func(time.Time) string) (string, error) {
+	for _, p := range layoutProbes {
+		if p.Format(layout) != format(p) {
+			return "", fmt.Errorf("%w: literal text clashes with layout %q", ErrUnsupportedPattern, layout)
+		}
+	}
+	return layout, nil
+}
+
+// fractionLayout returns the layout for n fractional second digits,
+// which Go accepts only right after a dot or a comma.
+func fractionLayout(prefix string, n int) (string, bool) {
+	if n > 9 || !strings.HasSuffix(prefix, ".") && !strings.HasSuffix(prefix, ",") {
+		return "", false
+	}
+	return strings.Repeat("0", n), true
+}
+
+// scanStrftime calls f with each directive of the pattern or with a literal.
+func scanStrftime(pattern string, f func(verb rune, nopad bool, lit string)) {
+	for i := 0; i < len(pattern); {
+		j := strings.IndexByte(pattern[i:], '%')
+		if j < 0 {
+			f(0, false, pattern[i:])
+			return
+		}
+		if j > 0 {
+			f(0, false, pattern[i:i+j])
+		}
+		start := i + j
+		i = start + 1
+
+		nopad := false
+		if i < len(pattern) && pattern[i] == '-' {
+			nopad = true
+			i++
+		}
+		if i >= len(pattern) {
+			f(0, false, pattern[start:])
+			return
+		}
+		verb, size := utf8.DecodeRuneInString(pattern[i:])
+		i += size
+		f(verb, nopad, "")
+	}
+}
+
+// scanCLDR calls f with each field of the pattern and its length or with a literal.
+func scanCLDR(pattern string, f func(field rune, n int, lit string)) {
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r == '\'':
+			if i+1 < len(runes) && runes[i+1] == '\'' {
+				f(0, 0, "'")
+				i += 2
+				continue
+			}
+			sb := strings.Builder{}
+			for i++; i < len(runes); i++ {
+				if runes[i] == '\'' {
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						sb.WriteRune('\'')
+						i++
+						continue
+					}
+					break
+				}
+				sb.WriteRune(runes[i])
+			}
+			i++
+			f(0, 0, sb.String())
+		case r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z':
+			n := 1
+			for i+n < len(runes) && runes[i+n] == r {
+				n++
+			}
+			f(r, n, "")
+			i += n
+		default:
+			f(0, 0, string(r))
+			i++
+		}
+	}
+}
+
+// strftime formats the time using a strftime pattern and names from the locale.
+func strftime(t time.Time, pattern string, loc *Locale) string {
+	sb := strings.Builder{}
+	scanStrftime(pattern, func(verb rune, nopad bool, lit string) {
+		if verb == 0 {
+			sb.WriteString(lit)
+			return
+		}
+		pad := func(x, width int) string {
+			if nopad {
+				return strconv.Itoa(x)
+			}
+			return padInt(x, width, '0')
+		}
+
+		switch verb {
+		case 'a':
+			sb.WriteString(loc.weekday(t.Weekday(), true))
+		case 'A':
+			sb.WriteString(loc.weekday(t.Weekday(), false))
+		case 'b', 'h':
+			sb.WriteString(loc.month(t.Month(), true, false))
+		case 'B':
+			sb.WriteString(loc.month(t.Month(), false, false))
+		case 'c':
+			sb.WriteString(strftime(t, "%a %b %e %H:%M:%S %Y", loc))
+		case 'C':
+			sb.WriteString(pad(t.Year()/100, 2))
+		case 'd':
+			sb.WriteString(pad(t.Day(), 2))
+		case 'e':
+			if nopad {
+				sb.WriteString(strconv.Itoa(t.Day()))
+			} else {
+				sb.WriteString(padInt(t.Day(), 2, ' '))
+			}
+		case 'D':
+			sb.WriteString(strftime(t, "%m/%d/%y", loc))
+		case 'f':
+			sb.WriteString(padInt(t.Nanosecond()/1000, 6, '0'))
+		case 'F':
+			sb.WriteString(strftime(t, "%Y-%m-%d", loc))
+		case 'G':
+			y, _ := t.ISOWeek()
+			sb.WriteString(strconv.Itoa(y))
+		case 'H':
+			sb.WriteString(pad(t.Hour(), 2))
+		case 'I':
+			sb.WriteString(pad(hour12(t.Hour()), 2))
+		case 'j':
+			sb.WriteString(pad(t.YearDay(), 3))
+		case 'm':
+			sb.WriteString(pad(int(t.Month()), 2))
+		case 'M':
+			sb.WriteString(pad(t.Minute(), 2))
+		case 'n':
+			sb.WriteByte('\n')
+		case 'p':
+			sb.WriteString(loc.dayPeriod(t.Hour()))
+		case 'R':
+			sb.WriteString(strftime(t, "%H:%M", loc))
+		case 's':
+			sb.WriteString(strconv.FormatInt(t.Unix(), 10))
+		case 'S':
+			sb.WriteString(pad(t.Second(), 2))
+		case 't':
+			sb.WriteByte('\t')
+		case 'T', 'X':
+			sb.WriteString(strftime(t, "%H:%M:%S", loc))
+		case 'u':
+			sb.WriteString(strconv.Itoa((int(t.Weekday())+6)%7 + 1))
+		case 'U':
+			sb.WriteString(pad((t.YearDay()+6-int(t.Weekday()))/7, 2))
+		case 'V':
+			_, w := t.ISOWeek()
+			sb.WriteString(pad(w, 2))
+		case 'w':
+			sb.WriteString(strconv.Itoa(int(t.Weekday())))
+		case 'W':
+			sb.WriteString(pad((t.YearDay()+6-(int(t.Weekday())+6)%7)/7, 2))
+		case 'x':
+			sb.WriteString(strftime(t, "%m/%d/%y", loc))
+		case 'y':
+			sb.WriteString(pad(t.Year()%100, 2))
+		case 'Y':
+			sb.WriteString(strconv.Itoa(t.Year()))
+		case 'z':
+			sb.WriteString(t.Format("-0700"))
+		case 'Z':
+			sb.WriteString(t.Format("MST"))
+		case '%':
+			sb.WriteByte('%')
+		default:
+			sb.WriteByte('%')
+			if nopad {
+				sb.WriteByte('-')
+			}
+			sb.WriteRune(verb)
+		}
+	})
+	return sb.String()
+}
+
+// cldrField formats a single CLDR field of length n.
+func cldrField(t time.Time, field rune, n int, loc *Locale) string {
+	switch field {
+	case 'G':
+		if t.Year() <= 0 {
+			return "BC"
+		}
+		return "AD"
+	case 'y':
+		if n == 2 {
+			return padInt(t.Year()%100, 2, '0')
+		}
+		return padInt(t.Year(), n, '0')
+	case 'M', 'L':
+		switch {
+		case n <= 2:
+			return padInt(int(t.Month()), n, '0')
+		case n == 3:
+			return loc.month(t.Month(), true, field == 'L')
+		case n == 4:
+			return loc.month(t.Month(), false, field == 'L')
+		}
+		return firstRune(loc.month(t.Month(), false, true))
+	case 'Q', 'q':
+		q := quarterOf(t.Month())
+		switch {
+		case n <= 2:
+			return padInt(q, n, '0')
+		case n == 3:
+			return loc.ShortQuarters[q-1]
+		}
+		return loc.Quarters[q-1]
+	case 'd':
+		return padInt(t.Day(), n, '0')
+	case 'D':
+		return padInt(t.YearDay(), n, '0')
+	case 'E':
+		switch {
+		case n <= 3:
+			return loc.weekday(t.Weekday(), true)
+		case n == 4:
+			return loc.weekday(t.Weekday(), false)
+		}
+		return firstRune(loc.weekday(t.Weekday(), false))
+	case 'a':
+		return loc.dayPeriod(t.Hour())
+	case 'h':
+		return padInt(hour12(t.Hour()), n, '0')
+	case 'H':
+		return padInt(t.Hour(), n, '0')
+	case 'K':
+		return padInt(t.Hour()%12, n, '0')
+	case 'k':
+		if t.Hour() == 0 {
+			return padInt(24, n, '0')
+		}
+		return padInt(t.Hour(), n, '0')
+	case 'm':
+		return padInt(t.Minute(), n, '0')
+	case 's':
+		return padInt(t.Second(), n, '0')
+	case 'S':
+		frac := padInt(t.Nanosecond(), 9, '0')
+		if n > 9 {
+			return frac + strings.Repeat("0", n-9)
+		}
+		return frac[:n]
+	case 'z':
+		return t.Format("MST")
+	case 'Z':
+		switch {
+		case n <= 3:
+			return t.Format("-0700")
+		case n == 4:
+			return "GMT" + t.Format("-07:00")
+		}
+		return t.Format("Z07:00")
+	case 'X':
+		return t.Format([]string{"Z07", "Z0700", "Z07:00"}[min(n, 3)-1])
+	case 'x':
+		return t.Format([]string{"-07", "-0700", "-07:00"}[min(n, 3)-1])
+	}
+	return strings.Repeat(string(field), n)
+}
+
+// padInt left-pads x with c up to width.
+func padInt(x, width int, c byte) string {
+	s := strconv.Itoa(x)
+	if len(s) >= width {
+		return s
+	}
+	return strings.Repeat(string(c), width-len(s)) + s
+}
+
+// hour12 converts the hour to the 12-hour clock.
+func hour12(h int) int {
+	if h%12 == 0 {
+		return 12
+	}
+	return h % 12
+}
+
+// firstRune returns the first character of the string.
+func firstRune(s string) string {
+	_, size := utf8.DecodeRuneInString(s)
+	return s[:size]
+}
@@ -0,0 +1,135 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrftime(t *testing.T) {
+	t.Parallel()
+
+	tm := time.Date(2026, 3, 3, 14, 5, 9, 123456789, time.FixedZone("MSK", 3*60*60))
+
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{pattern: "%d %b %Y", want: "03 Mar 2026"},
+		{pattern: "%-d %B %Y", want: "3 March 2026"},
+		{pattern: "%A, %e %b", want: "Tuesday,  3 Mar"},
+		{pattern: "%Y-%m-%dT%H:%M:%S.%f%z", want: "2026-03-03T14:05:09.123456+0300"},
+		{pattern: "%I:%M %p %Z", want: "02:05 PM MSK"},
+		{pattern: "%-I%p", want: "2PM"},
+		{pattern: "%F %T", want: "2026-03-03 14:05:09"},
+		{pattern: "%j %u %w %V %G", want: "062 2 2 10 2026"},
+		{pattern: "%y/%-m", want: "26/3"},
+		{pattern: "100%% %q", want: "100% %q"},
+		{pattern: "trailing %", want: "trailing %"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.pattern, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, Strftime(tm, tt.pattern))
+		})
+	}
+}
+
+func TestFormatCLDR(t *testing.T) {
+	t.Parallel()
+
+	tm := time.Date(2026, 3, 3, 0, 5, 9, 123456789, time.FixedZone("CET", 60*60))
+
+	tests := []struct {
+		pattern string
+		locale  *Locale
+		want    string
+	}{
+		{pattern: "dd MMM yyyy", want: "03 Mar 2026"},
+		{pattern: "d MMMM y", want: "3 March 2026"},
+		{pattern: "EEEE, d. M. yy", want: "Tuesday, 3. 3. 26"},
+		{pattern: "yyyy-MM-dd'T'HH:mm:ss.SSSXXX", want: "2026-03-03T00:05:09.123+01:00"},
+		{pattern: "h:mm a z", want: "12:05 AM CET"},
+		{pattern: "k:mm", want: "24:05"},
+		{pattern: "'o''clock' ''", want: "o'clock '"},
+		{pattern: "ZZZZ G", want: "GMT+01:00 AD"},
+		{pattern: "d MMMM yyyy", locale: Russian, want: "3 марта 2026"},
+		{pattern: "LLLL yyyy, EEEE", locale: Russian, want: "март 2026, вторник"},
+		{pattern: "MMMMM EEEEE", want: "M T"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.pattern, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, FormatCLDR(tm, tt.pattern, tt.locale))
+		})
+	}
+}
+
+func TestStrftimeLayout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		pattern string
+		want    string
+		wantErr bool
+	}{
+		{pattern: "%d %b %Y", want: "02 Jan 2006"},
+		{pattern: "%-d %b %Y %H:%M", want: "2 Jan 2006 15:04"},
+		{pattern: "%Y-%m-%dT%H:%M:%S.%f%z", want: "2006-01-02T15:04:05.000000-0700"},
+		{pattern: "%I:%M %p", want: "03:04 PM"},
+		{pattern: "%u", wantErr: true},
+		{pattern: "%f", wantErr: true},
+		{pattern: "week 1 of %Y", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.pattern, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := StrftimeLayout(tt.pattern)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrUnsupportedPattern)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCLDRLayout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		pattern string
+		want    string
+		wantErr bool
+	}{
+		{pattern: "dd MMM yyyy", want: "02 Jan 2006"},
+		{pattern: "d.M.yy h:mm a", want: "2.1.06 3:04 PM"},
+		{pattern: "yyyy-MM-dd'T'HH:mm:ss.SSSXXX", want: "2006-01-02T15:04:05.000Z07:00"},
+		{pattern: "EEEE, MMMM d", want: "Monday, January 2"},
+		{pattern: "H:mm", wantErr: true},
+		{pattern: "'Jan' yyyy", wantErr: true},
+		{pattern: "QQQ yyyy", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.pattern, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := CLDRLayout(tt.pattern)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrUnsupportedPattern)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
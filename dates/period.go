@@ -0,0 +1,167 @@
+package dates
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ISOWeek returns the ISO 8601 week date string in the format "year-Wweek", e.g. "2026-W42".
+func ISOWeek(t time.Time) string {
+	y, w := t.ISOWeek()
+	return fmt.Sprintf("%04d-W%02d", y, w)
+}
+
+// ParseISOWeek parses ISO 8601 week dates like "2026-W42", "2026W42" or "2026-W42-3"
+// and returns the midnight of that week's Monday, or of the given weekday, in UTC.
+func ParseISOWeek(s string) (time.Time, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	ys, rest, ok := strings.Cut(s, "W")
+	ys = strings.TrimSuffix(ys, "-")
+	if !ok || len(ys) != 4 || !isDigits(ys) {
+		return time.Time{}, fmt.Errorf("%w: bad ISO week %q", ErrInvalidDate, s)
+	}
+
+	ws, ds := rest, ""
+	switch {
+	case len(rest) == 4 && rest[2] == '-':
+		ws, ds = rest[:2], rest[3:]
+	case len(rest) == 3:
+		ws, ds = rest[:2], rest[2:]
+	}
+	y, yerr := strconv.Atoi(ys)
+	w, werr := strconv.Atoi(ws)
+	d := 1
+	if ds != "" {
+		var err error
+		if d, err = strconv.Atoi(ds); err != nil || !isDigits(ds) || d < 1 || d > 7 {
+			return time.Time{}, fmt.Errorf("%w: bad ISO weekday in %q", ErrInvalidDate, s)
+		}
+	}
+	if yerr != nil || werr != nil || len(ws) != 2 || !isDigits(ws) {
+		return time.Time{}, fmt.Errorf("%w: bad ISO week %q", ErrInvalidDate, s)
+	}
+
+	jan4 := time.Date(y, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := jan4.AddDate(0, 0, -(int(jan4.Weekday())+6)%7+(w-1)*7)
+	if gy, gw := monday.ISOWeek(); gy != y || gw != w {
+		return time.Time{}, fmt.Errorf("%w: year %d has no week %d", ErrInvalidDate, y, w)
+	}
+
+	return monday.AddDate(0, 0, d-1), nil
+}
+
+// isDigits defines if s consists of ASCII digits only.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// Quarter returns the quarter and the year in the locale, English if nil,
+// e.g. "Q3 2026".
+func Quarter(t time.Time, locale *Locale) string {
+	return FormatCLDR(t, "QQQ y", locale)
+}
+
+// ParseQuarter parses quarters like "Q3 2026", "2026-Q3" or the output of Quarter
+// in the locale, English if nil, and returns the midnight of the first day
+// of the quarter in UTC.
+func ParseQuarter(s string, locale *Locale) (time.Time, error) {
+	loc := locale.orDefault()
+	in := strings.TrimSpace(s)
+	if len(in) > 4 && in[4] == '-' {
+		in = in[:4] + " " + in[5:]
+	}
+	fields := strings.Fields(in)
+	if len(fields) == 1 {
+		if i := strings.IndexAny(fields[0], "Qq"); i > 0 {
+			fields = []string{fields[0][:i], fields[0][i:]}
+		}
+	}
+	if len(fields) < 2 {
+		return time.Time{}, fmt.Errorf("%w: bad quarter %q", ErrInvalidDate, s)
+	}
+
+	label, ys := strings.Join(fields[:len(fields)-1], " "), fields[len(fields)-1]
+	if _, err := strconv.Atoi(fields[0]); err == nil && len(fields[0]) == 4 {
+		label, ys = strings.Join(fields[1:], " "), fields[0]
+	}
+	y, err := strconv.Atoi(ys)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: bad year in %q", ErrInvalidDate, s)
+	}
+
+	for i := 0; i < 4; i++ {
+		for _, name := range []string{loc.ShortQuarters[i], loc.Quarters[i], English.ShortQuarters[i]} {
+			if strings.EqualFold(label, name) {
+				return time.Date(y, time.Month(i*3+1), 1, 0, 0, 0, 0, time.UTC), nil
+			}
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%w: bad quarter %q", ErrInvalidDate, s)
+}
+
+// FiscalYear returns the fiscal year and quarter of t for a fiscal year
+// starting in fiscalStartMonth. The fiscal year is named after the calendar
+// year it ends in.
+func FiscalYear(t time.Time, fiscalStartMonth time.Month) (year, quarter int) {
+	if fiscalStartMonth < time.January || fiscalStartMonth > time.December {
+		fiscalStartMonth = time.January
+	}
+	shift := int(t.Month() - fiscalStartMonth)
+	year = t.Year()
+	if shift < 0 {
+		shift += 12
+	} else if fiscalStartMonth != time.January {
+		year++
+	}
+	return year, shift/3 + 1
+}
+
+// FiscalPeriod returns the fiscal year and quarter of t in the format "FYyear Qquarter",
+// e.g. "FY2027 Q1" for April 2026 when the fiscal year starts in April.
+func FiscalPeriod(t time.Time, fiscalStartMonth time.Month) string {
+	y, q := FiscalYear(t, fiscalStartMonth)
+	return fmt.Sprintf("FY%d Q%d", y, q)
+}
+
+// ParseFiscalPeriod parses strings like "FY2027 Q1" or "FY2027" and returns the midnight
+// of the first day of the period in UTC for a fiscal year starting in fiscalStartMonth.
+func ParseFiscalPeriod(s string, fiscalStartMonth time.Month) (time.Time, error) {
+	if fiscalStartMonth < time.January || fiscalStartMonth > time.December {
+		fiscalStartMonth = time.January
+	}
+
+	fields := strings.Fields(strings.ToUpper(s))
+	if len(fields) == 0 || len(fields) > 2 || !strings.HasPrefix(fields[0], "FY") {
+		return time.Time{}, fmt.Errorf("%w: bad fiscal period %q", ErrInvalidDate, s)
+	}
+	y, err := strconv.Atoi(fields[0][2:])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: bad fiscal year in %q", ErrInvalidDate, s)
+	}
+
+	q := 1
+	if len(fields) == 2 {
+		q, err = strconv.Atoi(strings.TrimPrefix(fields[1], "Q"))
+		if err != nil || q < 1 || q > 4 || !strings.HasPrefix(fields[1], "Q") {
+			return time.Time{}, fmt.Errorf("%w: bad fiscal quarter in %q", ErrInvalidDate, s)
+		}
+	}
+
+	if fiscalStartMonth != time.January {
+		y--
+	}
+	return time.Date(y, fiscalStartMonth+time.Month(q-1)*3, 1, 0, 0, 0, 0, time.UTC), nil
+}
+
+// quarterOf returns the calendar quarter of the month.
+func quarterOf(m time.Month) int {
+	return (int(m)-1)/3 + 1
+}
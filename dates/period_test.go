@@ -0,0 +1,173 @@
+package dates
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestISOWeek(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		t    time.Time
+		want string
+	}{
+		{t: time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC), want: "2026-W42"},
+		{t: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), want: "2026-W53"},
+		{t: time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC), want: "2025-W01"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, ISOWeek(tt.t))
+		})
+	}
+}
+
+func TestParseISOWeek(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s       string
+		want    time.Time
+		wantErr bool
+	}{
+		{s: "2026-W42", want: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)},
+		{s: "2026W42", want: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)},
+		{s: "2026-W42-3", want: time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)},
+		{s: "2026W427", want: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{s: "2025-W01", want: time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)},
+		{s: "2026-W53", want: time.Date(2026, 12, 28, 0, 0, 0, 0, time.UTC)},
+		{s: "2025-W53", wantErr: true},
+		{s: "2026-W00", wantErr: true},
+		{s: "2026-W4", wantErr: true},
+		{s: "2026-W42-8", wantErr: true},
+		{s: "26-W42", wantErr: true},
+		{s: "2026-W+1", wantErr: true},
+		{s: "2026-W-1", wantErr: true},
+		{s: "2026-W42-+", wantErr: true},
+		{s: "+202-W42", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseISOWeek(tt.s)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidDate)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestQuarter(t *testing.T) {
+	t.Parallel()
+
+	tm := time.Date(2026, 8, 15, 0, 0, 0, 0, time.UTC)
+
+	require.Equal(t, "Q3 2026", Quarter(tm, nil))
+	require.Equal(t, "3-й кв. 2026", Quarter(tm, Russian))
+	require.Equal(t, "3rd quarter 2026", FormatCLDR(tm, "QQQQ y", nil))
+}
+
+func TestParseQuarter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s       string
+		locale  *Locale
+		want    time.Time
+		wantErr bool
+	}{
+		{s: "Q3 2026", want: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{s: "q1 2026", want: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{s: "2026-Q4", want: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{s: "2026Q2", want: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{s: "2nd quarter 2026", want: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{s: "3-й кв. 2026", locale: Russian, want: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{s: "Q5 2026", wantErr: true},
+		{s: "Q3", wantErr: true},
+		{s: "Q3 twenty", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseQuarter(tt.s, tt.locale)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidDate)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFiscalPeriod(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		t     time.Time
+		start time.Month
+		want  string
+	}{
+		{t: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), start: time.April, want: "FY2027 Q1"},
+		{t: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), start: time.April, want: "FY2027 Q3"},
+		{t: time.Date(2027, 3, 31, 0, 0, 0, 0, time.UTC), start: time.April, want: "FY2027 Q4"},
+		{t: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), start: time.October, want: "FY2027 Q1"},
+		{t: time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC), start: time.January, want: "FY2026 Q3"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, FiscalPeriod(tt.t, tt.start))
+		})
+	}
+}
+
+func TestParseFiscalPeriod(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s       string
+		start   time.Month
+		want    time.Time
+		wantErr bool
+	}{
+		{s: "FY2027 Q1", start: time.April, want: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{s: "FY2027 Q4", start: time.April, want: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{s: "fy2027", start: time.October, want: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{s: "FY2026 Q3", start: time.January, want: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{s: "FY2026 Q5", start: time.April, wantErr: true},
+		{s: "2026 Q1", start: time.April, wantErr: true},
+		{s: "FY2026 1", start: time.April, wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseFiscalPeriod(tt.s, tt.start)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidDate)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.True(t, strings.HasPrefix(FiscalPeriod(got, tt.start), strings.ToUpper(tt.s)), "round trip")
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package dates
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// rangeDash joins numbers of a range, e.g. "3–7"
+	rangeDash = "–"
+	// rangeSep joins composite parts of a range, e.g. "28 Feb – 3 Mar"
+	rangeSep = " – "
+)
+
+// RangeOptions configures FormatRange.
+type RangeOptions struct {
+	// Smallest is the smallest unit shown: Year, Month, Day or Minute.
+	// Day by default.
+	Smallest Unit
+	// Locale provides month names, English by default.
+	Locale *Locale
+}
+
+// FormatRange returns a short representation of the interval in the style of ShortDMY
+// collapsing the parts both ends share, e.g. "3–7 Mar 2026", "28 Feb – 3 Mar 2026"
+// or "Dec 2025 – Jan 2026". With Minute precision a same-day range is rendered
+// as "3 Mar 2026 10:00–12:30". The end is converted to the location of the start
+// and the ends are swapped if the end comes first.
+func FormatRange(start, end time.Time, opts RangeOptions) string {
+	loc := opts.Locale.orDefault()
+	if opts.Smallest == 0 {
+		opts.Smallest = Day
+	}
+	end = end.In(start.Location())
+	if end.Before(start) {
+		start, end = end, start
+	}
+
+	sameYear := start.Year() == end.Year()
+	sameMonth := sameYear && start.Month() == end.Month()
+	sameDay := sameMonth && start.Day() == end.Day()
+
+	switch {
+	case opts.Smallest >= Year:
+		if sameYear {
+			return strconv.Itoa(start.Year())
+		}
+		return strconv.Itoa(start.Year()) + rangeDash + strconv.Itoa(end.Year())
+	case opts.Smallest >= Month:
+		switch {
+		case sameMonth:
+			return rangeDate(start, loc, false, true, true)
+		case sameYear:
+			return loc.month(start.Month(), true, false) + rangeSep + rangeDate(end, loc, false, true, true)
+		}
+		return rangeDate(start, loc, false, true, true) + rangeSep + rangeDate(end, loc, false, true, true)
+	case opts.Smallest >= Day:
+		switch {
+		case sameDay:
+			return rangeDate(start, loc, true, true, true)
+		case sameMonth:
+			return strconv.Itoa(start.Day()) + rangeDash + rangeDate(end, loc, true, true, true)
+		case sameYear:
+			return rangeDate(start, loc, true, true, false) + rangeSep + rangeDate(end, loc, true, true, true)
+		}
+		return rangeDate(start, loc, true, true, true) + rangeSep + rangeDate(end, loc, true, true, true)
+	}
+
+	from := rangeDate(start, loc, true, true, true) + " " + start.Format("15:04")
+	if !sameDay {
+		return from + rangeSep + rangeDate(end, loc, true, true, true) + " " + end.Format("15:04")
+	}
+	if start.Hour() == end.Hour() && start.Minute() == end.Minute() {
+		return from
+	}
+	return from + rangeDash + end.Format("15:04")
+}
+
+// rangeDate renders the chosen parts of the date like ShortDMY does.
+func rangeDate(t time.Time, loc *Locale, day, month, year bool) string {
+	parts := make([]string, 0, 3)
+	if day {
+		parts = append(parts, strconv.Itoa(t.Day()))
+	}
+	if month {
+		parts = append(parts, loc.month(t.Month(), true, false))
+	}
+	if year {
+		parts = append(parts, strconv.Itoa(t.Year()))
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,87 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatRange(t *testing.T) {
+	t.Parallel()
+
+	date := func(y int, m time.Month, d, h, min int) time.Time {
+		return time.Date(y, m, d, h, min, 0, 0, time.UTC)
+	}
+
+	type args struct {
+		start time.Time
+		end   time.Time
+		opts  RangeOptions
+	}
+	tests := []struct {
+		args args
+		want string
+	}{
+		{
+			args: args{start: date(2026, 3, 3, 0, 0), end: date(2026, 3, 7, 0, 0)},
+			want: "3–7 Mar 2026",
+		},
+		{
+			args: args{start: date(2026, 2, 28, 0, 0), end: date(2026, 3, 3, 0, 0)},
+			want: "28 Feb – 3 Mar 2026",
+		},
+		{
+			args: args{start: date(2025, 12, 28, 0, 0), end: date(2026, 1, 3, 0, 0)},
+			want: "28 Dec 2025 – 3 Jan 2026",
+		},
+		{
+			args: args{start: date(2026, 3, 3, 9, 0), end: date(2026, 3, 3, 18, 0)},
+			want: "3 Mar 2026",
+		},
+		{
+			args: args{start: date(2026, 3, 7, 0, 0), end: date(2026, 3, 3, 0, 0)},
+			want: "3–7 Mar 2026",
+		},
+		{
+			args: args{start: date(2025, 12, 1, 0, 0), end: date(2026, 1, 31, 0, 0), opts: RangeOptions{Smallest: Month}},
+			want: "Dec 2025 – Jan 2026",
+		},
+		{
+			args: args{start: date(2026, 1, 1, 0, 0), end: date(2026, 3, 31, 0, 0), opts: RangeOptions{Smallest: Month}},
+			want: "Jan – Mar 2026",
+		},
+		{
+			args: args{start: date(2026, 3, 1, 0, 0), end: date(2026, 3, 31, 0, 0), opts: RangeOptions{Smallest: Month}},
+			want: "Mar 2026",
+		},
+		{
+			args: args{start: date(2025, 3, 1, 0, 0), end: date(2026, 3, 31, 0, 0), opts: RangeOptions{Smallest: Year}},
+			want: "2025–2026",
+		},
+		{
+			args: args{start: date(2026, 3, 3, 10, 0), end: date(2026, 3, 3, 12, 30), opts: RangeOptions{Smallest: Minute}},
+			want: "3 Mar 2026 10:00–12:30",
+		},
+		{
+			args: args{start: date(2026, 3, 3, 10, 0), end: date(2026, 3, 3, 10, 0), opts: RangeOptions{Smallest: Minute}},
+			want: "3 Mar 2026 10:00",
+		},
+		{
+			args: args{start: date(2026, 3, 3, 22, 0), end: date(2026, 3, 4, 2, 0), opts: RangeOptions{Smallest: Minute}},
+			want: "3 Mar 2026 22:00 – 4 Mar 2026 02:00",
+		},
+		{
+			args: args{start: date(2026, 2, 28, 0, 0), end: date(2026, 3, 3, 0, 0), opts: RangeOptions{Locale: Russian}},
+			want: "28 фев – 3 мар 2026",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, FormatRange(tt.args.start, tt.args.end, tt.args.opts))
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package dates
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidDate is returned when a date string can not be parsed.
+var ErrInvalidDate = errors.New("dates: invalid date")
+
+// absoluteLayouts are layouts ParseRelative tries before parsing phrases.
+var absoluteLayouts = []string{
+	"02.01.2006 15:04",
+	"02.01.2006",
+	"2 Jan 2006",
+	"2 January 2006",
+	"Jan 2006 15:04",
+	"Jan 2006",
+	"January 2006",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	time.RFC3339,
+}
+
+// ParseRelative parses the date strings produced by Dynamic, ShortDMY, ShortMY
+// and ShortMYHM as well as common phrases like "in 3 days", "2 hours ago",
+// "tomorrow 09:00", "next monday" or "last friday 18:00".
+// Relative phrases are resolved against now, and wall clock values are
+// interpreted in loc, or in the location of now if loc is nil.
+// A bare weekday means its nearest occurrence starting from today
+// and "this" refers to the current week starting on Monday.
+// As in Dynamic, "today 15:00" is the last 15:00 within a day before now
+// and "yesterday 15:00" is the last 15:00 a day or two before now.
+func ParseRelative(s string, now time.Time, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = now.Location()
+	}
+	now = now.In(loc)
+
+	s = strings.Join(strings.Fields(s), " ")
+	if s == "" {
+		return time.Time{}, fmt.Errorf("%w: empty string", ErrInvalidDate)
+	}
+	for _, layout := range absoluteLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	words := strings.Fields(strings.ToLower(s))
+	switch words[0] {
+	case "now":
+		if len(words) == 1 {
+			return now, nil
+		}
+	case "just":
+		if len(words) == 2 && words[1] == "now" {
+			return now, nil
+		}
+	case "today":
+		return lastClock(now, words[1:], s)
+	case "yesterday":
+		return lastClock(now.Add(-24*time.Hour), words[1:], s)
+	case "tomorrow":
+		return atClock(now.AddDate(0, 0, 1), words[1:], s)
+	case "in":
+		return shift(now, words[1:], 1, s)
+	case "next", "last", "this":
+		return relativeWeekday(now, words, s)
+	}
+	if words[len(words)-1] == "ago" {
+		return shift(now, words[:len(words)-1], -1, s)
+	}
+	if _, ok := weekdays[words[0]]; ok {
+		return relativeWeekday(now, append([]string{""}, words...), s)
+	}
+
+	return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidDate, s)
+}
+
+// weekdays maps lowercased weekday names to time.Weekday.
+var weekdays = func() map[string]time.Weekday {
+	m := make(map[string]time.Weekday, 14)
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		name := strings.ToLower(d.String())
+		m[name] = d
+		m[name[:3]] = d
+	}
+	return m
+}()
+
+// atClock returns the day of t at the wall clock given in words,
+// or at midnight if words are empty.
+func atClock(t time.Time, words []string, s string) (time.Time, error) {
+	if len(words) > 0 && words[0] == "at" {
+		words = words[1:]
+	}
+	if len(words) > 1 {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidDate, s)
+	}
+
+	var h, m int
+	if len(words) == 1 {
+		clock, err := time.Parse("15:04", words[0])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: bad time %q", ErrInvalidDate, words[0])
+		}
+		h, m = clock.Hour(), clock.Minute()
+	}
+
+	return time.Date(t.Year(), t.Month(), t.Day(), h, m, 0, 0, t.Location()), nil
+}
+
+// lastClock returns the last wall clock given in words not after t,
+// or the midnight of the day of t if words are empty.
+func lastClock(t time.Time, words []string, s string) (time.Time, error) {
+	clock, err := atClock(t, words, s)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if clock.After(t) {
+		clock = clock.AddDate(0, 0, -1)
+	}
+	return clock, nil
+}
+
+// shift moves now by the amounts listed in words like "3 days 2 hours".
+// Days and larger units are added to the calendar date.
+func shift(now time.Time, words []string, sign int, s string) (time.Time, error) {
+	if len(words) == 0 {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidDate, s)
+	}
+
+	t := now
+	for i := 0; i < len(words); i += 2 {
+		if words[i] == "and" && i > 0 {
+			i--
+			continue
+		}
+		if i+1 >= len(words) {
+			return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidDate, s)
+		}
+
+		n, err := strconv.Atoi(words[i])
+		if words[i] == "a" || words[i] == "an" {
+			n, err = 1, nil
+		}
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: bad number %q", ErrInvalidDate, words[i])
+		}
+		n *= sign
+
+		unit, ok := durationUnits[strings.TrimSuffix(words[i+1], ",")]
+		if !ok {
+			return time.Time{}, fmt.Errorf("%w: unknown unit %q", ErrInvalidDate, words[i+1])
+		}
+		switch unit {
+		case Year.Duration():
+			t = t.AddDate(n, 0, 0)
+		case Month.Duration():
+			t = t.AddDate(0, n, 0)
+		case Week.Duration():
+			t = t.AddDate(0, 0, 7*n)
+		case Day.Duration():
+			t = t.AddDate(0, 0, n)
+		default:
+			t = t.Add(time.Duration(n) * unit)
+		}
+	}
+
+	return t, nil
+}
+
+// relativeWeekday resolves phrases like "next monday 10:00" or "last week".
+// The first word is one of "next", "last", "this" or empty.
+func relativeWeekday(now time.Time, words []string, s string) (time.Time, error) {
+	if len(words) < 2 {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidDate, s)
+	}
+
+	sign := 0
+	switch words[0] {
+	case "next":
+		sign = 1
+	case "last":
+		sign = -1
+	}
+
+	if sign != 0 && len(words) == 2 {
+		switch words[1] {
+		case "week":
+			return now.AddDate(0, 0, 7*sign), nil
+		case "month":
+			return now.AddDate(0, sign, 0), nil
+		case "year":
+			return now.AddDate(sign, 0, 0), nil
+		}
+	}
+
+	wd, ok := weekdays[words[1]]
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidDate, s)
+	}
+
+	diff := int(wd - now.Weekday())
+	switch {
+	case sign > 0 && diff <= 0:
+		diff += 7
+	case sign < 0 && diff >= 0:
+		diff -= 7
+	case sign == 0 && words[0] == "" && diff < 0:
+		diff += 7
+	case sign == 0 && words[0] == "this":
+		diff = (int(wd)+6)%7 - (int(now.Weekday())+6)%7
+	}
+
+	return atClock(now.AddDate(0, 0, diff), words[2:], s)
+}
@@ -0,0 +1,94 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRelative(t *testing.T) {
+	t.Parallel()
+
+	// Wednesday
+	now := time.Date(2026, 3, 4, 15, 20, 10, 0, time.UTC)
+	day := func(d, h, m int) time.Time {
+		return time.Date(2026, 3, d, h, m, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		s       string
+		want    time.Time
+		wantErr bool
+	}{
+		{s: "now", want: now},
+		{s: "just now", want: now},
+		{s: "5 minutes ago", want: now.Add(-5 * time.Minute)},
+		{s: "an hour ago", want: now.Add(-time.Hour)},
+		{s: "today 14:30", want: day(4, 14, 30)},
+		{s: "yesterday 09:05", want: day(3, 9, 5)},
+		{s: "Yesterday", want: day(3, 0, 0)},
+		{s: "tomorrow at 08:00", want: day(5, 8, 0)},
+		{s: "in 3 days", want: now.AddDate(0, 0, 3)},
+		{s: "in 1 month and 2 hours", want: now.AddDate(0, 1, 0).Add(2 * time.Hour)},
+		{s: "2 weeks ago", want: now.AddDate(0, 0, -14)},
+		{s: "next monday", want: day(9, 0, 0)},
+		{s: "next wednesday 10:00", want: day(11, 10, 0)},
+		{s: "last friday", want: time.Date(2026, 2, 27, 0, 0, 0, 0, time.UTC)},
+		{s: "last wednesday", want: time.Date(2026, 2, 25, 0, 0, 0, 0, time.UTC)},
+		{s: "this monday", want: day(2, 0, 0)},
+		{s: "friday 18:00", want: day(6, 18, 0)},
+		{s: "wed", want: day(4, 0, 0)},
+		{s: "next week", want: now.AddDate(0, 0, 7)},
+		{s: "03.03.2026 12:01", want: day(3, 12, 1)},
+		{s: ShortDMY(day(7, 0, 0)), want: day(7, 0, 0)},
+		{s: ShortMY(day(1, 0, 0)), want: day(1, 0, 0)},
+		{s: ShortMYHM(day(1, 23, 59)), want: day(1, 23, 59)},
+		{s: "", wantErr: true},
+		{s: "someday", wantErr: true},
+		{s: "in 3 parsecs", wantErr: true},
+		{s: "today 25:00", wantErr: true},
+		{s: "next moonday", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseRelative(tt.s, now, nil)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidDate)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tt.want.Equal(got), "want %s, got %s", tt.want, got)
+		})
+	}
+}
+
+func TestParseRelative_Location(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("MSK", 3*60*60)
+	now := time.Date(2026, 3, 4, 22, 0, 0, 0, time.UTC)
+
+	got, err := ParseRelative("tomorrow 10:00", now, loc)
+
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 3, 6, 10, 0, 0, 0, loc), got)
+}
+
+func TestParseRelative_Dynamic(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+	for m := 0; m <= 48*60+60; m += 7 {
+		tm := now.Add(-time.Duration(m) * time.Minute)
+		text := dynamic(tm, now)
+
+		got, err := ParseRelative(text, now, nil)
+
+		require.NoError(t, err, text)
+		require.True(t, tm.Equal(got), "%s: want %s, got %s", text, tm, got)
+	}
+}
@@ -0,0 +1,176 @@
+package dates
+
+import (
+	"fmt"
+	"time"
+)
+
+// TemplateContext carries the locale and the clock to the template functions.
+// Put it into the template data and pass it to the functions to get
+// deterministic output, e.g. {{ dynamic $.Dates .CreatedAt }}
+// or {{ .CreatedAt | shortDMY $.Dates }}.
+type TemplateContext struct {
+	// Locale provides names, English by default.
+	Locale *Locale
+	// Now is the current time, time.Now() if zero.
+	Now time.Time
+}
+
+// FuncMap returns the functions for text/template and html/template:
+//
+//	dynamic, dynamicShort, shortDMY, shortMY, shortMYHM,
+//	longDMY, longMDY, weekdayDM, weekdayDMY, ordinalDay, isoWeek, quarter
+//	take a time.Time;
+//	strftime and formatCLDR take a pattern and a time.Time;
+//	formatRange takes two time.Time values;
+//	humanizeDuration takes a time.Duration;
+//	ordinal takes an int.
+//
+// Every function accepts an optional TemplateContext argument in any position.
+func FuncMap() map[string]any {
+	return map[string]any{
+		"dynamic": timeFunc("dynamic", func(t time.Time, ctx TemplateContext) string {
+			return localDynamic(t, ctx.now(), ctx.Locale)
+		}),
+		"dynamicShort": timeFunc("dynamicShort", func(t time.Time, ctx TemplateContext) string {
+			return dynamicShort(t, ctx.now(), CompactOptions{Locale: ctx.Locale})
+		}),
+		"shortDMY": timeFunc("shortDMY", func(t time.Time, ctx TemplateContext) string {
+			return strftime(t, "%-d %b %Y", ctx.Locale.orDefault())
+		}),
+		"shortMY": timeFunc("shortMY", func(t time.Time, ctx TemplateContext) string {
+			return strftime(t, "%b %Y", ctx.Locale.orDefault())
+		}),
+		"shortMYHM": timeFunc("shortMYHM", func(t time.Time, ctx TemplateContext) string {
+			return strftime(t, "%b %Y %H:%M", ctx.Locale.orDefault())
+		}),
+		"longDMY": timeFunc("longDMY", func(t time.Time, ctx TemplateContext) string {
+			return LongDMY(t, ctx.Locale)
+		}),
+		"longMDY": timeFunc("longMDY", func(t time.Time, ctx TemplateContext) string {
+			return LongMDY(t, ctx.Locale)
+		}),
+		"weekdayDM": timeFunc("weekdayDM", func(t time.Time, ctx TemplateContext) string {
+			return WeekdayDM(t, ctx.Locale)
+		}),
+		"weekdayDMY": timeFunc("weekdayDMY", func(t time.Time, ctx TemplateContext) string {
+			return WeekdayDMY(t, ctx.Locale)
+		}),
+		"ordinalDay": timeFunc("ordinalDay", func(t time.Time, ctx TemplateContext) string {
+			return OrdinalDay(t, ctx.Locale)
+		}),
+		"isoWeek": timeFunc("isoWeek", func(t time.Time, _ TemplateContext) string {
+			return ISOWeek(t)
+		}),
+		"quarter": timeFunc("quarter", func(t time.Time, ctx TemplateContext) string {
+			return Quarter(t, ctx.Locale)
+		}),
+		"strftime": func(args ...any) (string, error) {
+			a, err := parseTemplateArgs("strftime", args, 1, 1)
+			if err != nil {
+				return "", err
+			}
+			return strftime(a.times[0], a.strings[0], a.ctx.Locale.orDefault()), nil
+		},
+		"formatCLDR": func(args ...any) (string, error) {
+			a, err := parseTemplateArgs("formatCLDR", args, 1, 1)
+			if err != nil {
+				return "", err
+			}
+			return FormatCLDR(a.times[0], a.strings[0], a.ctx.Locale), nil
+		},
+		"formatRange": func(args ...any) (string, error) {
+			a, err := parseTemplateArgs("formatRange", args, 2, 0)
+			if err != nil {
+				return "", err
+			}
+			return FormatRange(a.times[0], a.times[1], RangeOptions{Locale: a.ctx.Locale}), nil
+		},
+		"humanizeDuration": func(args ...any) (string, error) {
+			a, err := parseTemplateArgs("humanizeDuration", args, 0, 0)
+			if err != nil {
+				return "", err
+			}
+			if len(a.durations) != 1 {
+				return "", fmt.Errorf("dates: humanizeDuration: want 1 duration, got %d", len(a.durations))
+			}
+			return HumanizeDuration(a.durations[0], DurationOptions{Locale: a.ctx.Locale}), nil
+		},
+		"ordinal": func(args ...any) (string, error) {
+			a, err := parseTemplateArgs("ordinal", args, 0, 0)
+			if err != nil {
+				return "", err
+			}
+			if len(a.ints) != 1 {
+				return "", fmt.Errorf("dates: ordinal: want 1 number, got %d", len(a.ints))
+			}
+			return Ordinal(a.ints[0], a.ctx.Locale), nil
+		},
+	}
+}
+
+// templateArgs holds the arguments of a template function sorted by type.
+type templateArgs struct {
+	ctx       TemplateContext
+	times     []time.Time
+	durations []time.Duration
+	strings   []string
+	ints      []int
+}
+
+// now returns the clock of the context.
+func (ctx TemplateContext) now() time.Time {
+	if ctx.Now.IsZero() {
+		return time.Now()
+	}
+	return ctx.Now
+}
+
+// timeFunc wraps f into a template function taking a time and an optional context.
+func timeFunc(name string, f func(time.Time, TemplateContext) string) func(args ...any) (string, error) {
+	return func(args ...any) (string, error) {
+		a, err := parseTemplateArgs(name, args, 1, 0)
+		if err != nil {
+			return "", err
+		}
+		return f(a.times[0], a.ctx), nil
+	}
+}
+
+// parseTemplateArgs sorts the arguments by type and checks the number
+// of times and strings.
+func parseTemplateArgs(name string, args []any, times, strs int) (templateArgs, error) {
+	var a templateArgs
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case TemplateContext:
+			a.ctx = v
+		case *TemplateContext:
+			if v != nil {
+				a.ctx = *v
+			}
+		case time.Time:
+			a.times = append(a.times, v)
+		case *time.Time:
+			if v == nil {
+				return a, fmt.Errorf("dates: %s: nil time", name)
+			}
+			a.times = append(a.times, *v)
+		case time.Duration:
+			a.durations = append(a.durations, v)
+		case string:
+			a.strings = append(a.strings, v)
+		case int:
+			a.ints = append(a.ints, v)
+		default:
+			return a, fmt.Errorf("dates: %s: unexpected argument of type %T", name, arg)
+		}
+	}
+	if len(a.times) != times {
+		return a, fmt.Errorf("dates: %s: want %d times, got %d", name, times, len(a.times))
+	}
+	if len(a.strings) != strs {
+		return a, fmt.Errorf("dates: %s: want %d strings, got %d", name, strs, len(a.strings))
+	}
+	return a, nil
+}
@@ -0,0 +1,99 @@
+package dates
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFuncMap(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 4, 15, 0, 0, 0, time.UTC)
+	created := time.Date(2026, 3, 3, 9, 5, 0, 0, time.UTC)
+	data := map[string]any{
+		"En":      TemplateContext{Now: now},
+		"Ru":      &TemplateContext{Now: now, Locale: Russian},
+		"Created": created,
+		"Ends":    created.AddDate(0, 0, 4),
+		"Took":    2*time.Hour + 5*time.Minute,
+	}
+
+	tests := []struct {
+		tmpl string
+		want string
+	}{
+		{tmpl: `{{ dynamic $.En .Created }}`, want: "yesterday 09:05"},
+		{tmpl: `{{ .Created | dynamic $.En }}`, want: "yesterday 09:05"},
+		{tmpl: `{{ dynamic .Created .Ru }}`, want: "вчера 09:05"},
+		{tmpl: `{{ dynamicShort .Created .Ru }}`, want: "1 д"},
+		{tmpl: `{{ shortDMY .Created }}`, want: "3 Mar 2026"},
+		{tmpl: `{{ shortDMY .Created .Ru }}`, want: "3 мар 2026"},
+		{tmpl: `{{ shortMY .Created }}`, want: "Mar 2026"},
+		{tmpl: `{{ shortMYHM .Created }}`, want: "Mar 2026 09:05"},
+		{tmpl: `{{ longDMY .Created .Ru }}`, want: "3 марта 2026"},
+		{tmpl: `{{ longMDY .Created }}`, want: "March 3rd, 2026"},
+		{tmpl: `{{ weekdayDM .Created }}`, want: "Tuesday, 3 March"},
+		{tmpl: `{{ weekdayDMY .Created }}`, want: "Tuesday, 3 March 2026"},
+		{tmpl: `{{ ordinalDay .Created }}`, want: "the 3rd"},
+		{tmpl: `{{ isoWeek .Created }}`, want: "2026-W10"},
+		{tmpl: `{{ quarter .Created }}`, want: "Q1 2026"},
+		{tmpl: `{{ .Created | strftime "%d/%m" }}`, want: "03/03"},
+		{tmpl: `{{ formatCLDR "LLLL" .Created .Ru }}`, want: "март"},
+		{tmpl: `{{ formatRange .Created .Ends }}`, want: "3–7 Mar 2026"},
+		{tmpl: `{{ humanizeDuration .Took }}`, want: "2 hours 5 minutes"},
+		{tmpl: `{{ ordinal 22 }}`, want: "22nd"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.tmpl, func(t *testing.T) {
+			t.Parallel()
+
+			tmpl := template.Must(template.New("").Funcs(FuncMap()).Parse(tt.tmpl))
+			sb := strings.Builder{}
+
+			require.NoError(t, tmpl.Execute(&sb, data))
+			require.Equal(t, tt.want, sb.String())
+		})
+	}
+}
+
+func TestFuncMap_HTML(t *testing.T) {
+	t.Parallel()
+
+	tmpl := htmltemplate.Must(htmltemplate.New("").Funcs(FuncMap()).Parse(`<p>{{ dynamic .Ctx .T }}</p>`))
+	sb := strings.Builder{}
+	now := time.Date(2026, 3, 4, 15, 0, 0, 0, time.UTC)
+
+	err := tmpl.Execute(&sb, map[string]any{"Ctx": TemplateContext{Now: now}, "T": now.Add(-5 * time.Minute)})
+
+	require.NoError(t, err)
+	require.Equal(t, "<p>5 minutes ago</p>", sb.String())
+}
+
+func TestFuncMap_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{
+		`{{ dynamic }}`,
+		`{{ dynamic "now" }}`,
+		`{{ dynamic 1.5 }}`,
+		`{{ strftime .T }}`,
+		`{{ humanizeDuration .T }}`,
+		`{{ ordinal .T }}`,
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt, func(t *testing.T) {
+			t.Parallel()
+
+			tmpl := template.Must(template.New("").Funcs(FuncMap()).Parse(tt))
+
+			require.Error(t, tmpl.Execute(&strings.Builder{}, map[string]any{"T": time.Now()}))
+		})
+	}
+}
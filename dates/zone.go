@@ -0,0 +1,129 @@
+package dates
+
+import (
+	"strconv"
+	"time"
+)
+
+// ZoneStyle describes how a time zone is annotated.
+type ZoneStyle int8
+
+const (
+	// ZoneAbbrev appends the zone abbreviation, e.g. "MSK"
+	ZoneAbbrev ZoneStyle = iota + 1
+	// ZoneOffset appends the UTC offset, e.g. "UTC+3"
+	ZoneOffset
+	// ZoneAbbrevOffset appends both, e.g. "MSK (UTC+3)"
+	ZoneAbbrevOffset
+	// ZoneYourTime appends the abbreviation and the time converted
+	// to the reader's location, e.g. "MSK (12:30 your time)"
+	ZoneYourTime
+)
+
+// ZoneOptions configures time zone annotations.
+type ZoneOptions struct {
+	// Style is the annotation style, ZoneAbbrev by default.
+	Style ZoneStyle
+	// In is the reader's location. The time is converted to it before
+	// formatting, or only for the annotation with the ZoneYourTime style.
+	// Defaults to time.Local for ZoneYourTime and keeps the time as is otherwise.
+	In *time.Location
+	// Locale provides the "your time" label and month names, English by default.
+	Locale *Locale
+}
+
+// ZoneName returns the abbreviation of the zone of t, e.g. "MSK" or "CET".
+// Many zones have no abbreviation in the tzdata and only a numeric name like "+04";
+// the UTC offset is returned for them instead.
+func ZoneName(t time.Time) string {
+	name, _ := t.Zone()
+	if name == "" || name[0] == '+' || name[0] == '-' {
+		return UTCOffset(t)
+	}
+	return name
+}
+
+// UTCOffset returns the UTC offset of t, e.g. "UTC+3", "UTC-3:30" or "UTC".
+func UTCOffset(t time.Time) string {
+	_, offset := t.Zone()
+	if offset == 0 {
+		return "UTC"
+	}
+
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+	s := "UTC" + sign + strconv.Itoa(offset/3600)
+	if m := offset % 3600 / 60; m != 0 {
+		s += ":" + padInt(m, 2, '0')
+	}
+	return s
+}
+
+// ShortMYHMZone returns ShortMYHM followed by the time zone annotation,
+// e.g. "Mar 2026 14:30 MSK".
+func ShortMYHMZone(t time.Time, opts ZoneOptions) string {
+	t = zoneTime(t, opts)
+	return ShortMYHM(t) + " " + zoneAnnotation(t, opts)
+}
+
+// DynamicZone returns Dynamic followed by the time zone annotation
+// when the text shows a wall clock, e.g. "today 14:30 MSK".
+func DynamicZone(t time.Time, opts ZoneOptions) string {
+	return dynamicZone(t, time.Now(), opts)
+}
+
+// dynamicZone returns the annotated difference between t and now.
+func dynamicZone(t, now time.Time, opts ZoneOptions) string {
+	t = zoneTime(t, opts)
+	s := dynamic(t, now)
+	if spanOf(now.Sub(t)) < spanToday {
+		return s
+	}
+	return s + " " + zoneAnnotation(t, opts)
+}
+
+// zoneTime converts t to the reader's location unless it is shown aside.
+func zoneTime(t time.Time, opts ZoneOptions) time.Time {
+	if opts.In == nil || opts.Style == ZoneYourTime {
+		return t
+	}
+	return t.In(opts.In)
+}
+
+// zoneAnnotation returns the zone annotation of t.
+func zoneAnnotation(t time.Time, opts ZoneOptions) string {
+	switch opts.Style {
+	case ZoneOffset:
+		return UTCOffset(t)
+	case ZoneAbbrevOffset:
+		name, offset := ZoneName(t), UTCOffset(t)
+		if name == offset {
+			return name
+		}
+		return name + " (" + offset + ")"
+	case ZoneYourTime:
+		in := opts.In
+		if in == nil {
+			in = time.Local
+		}
+		local := t.In(in)
+		if offsetOf(local) == offsetOf(t) {
+			return ZoneName(t)
+		}
+		loc := opts.Locale.orDefault()
+		clock := strftime(local, "%H:%M", loc)
+		if local.YearDay() != t.YearDay() || local.Year() != t.Year() {
+			clock = strftime(local, "%-d %b %H:%M", loc)
+		}
+		return ZoneName(t) + " (" + clock + " " + loc.YourTime + ")"
+	}
+	return ZoneName(t)
+}
+
+// offsetOf returns the UTC offset of t in seconds.
+func offsetOf(t time.Time) int {
+	_, offset := t.Zone()
+	return offset
+}
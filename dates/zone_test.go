@@ -0,0 +1,117 @@
+package dates
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mustLoadLocation loads the location from the embedded tzdata.
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+func TestZoneName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		zone string
+		want string
+	}{
+		{zone: "Europe/Moscow", want: "MSK"},
+		{zone: "Europe/Berlin", want: "CET"},
+		{zone: "UTC", want: "UTC"},
+		{zone: "Asia/Dubai", want: "UTC+4"},
+		{zone: "America/Sao_Paulo", want: "UTC-3"},
+		{zone: "Asia/Kathmandu", want: "UTC+5:45"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.zone, func(t *testing.T) {
+			t.Parallel()
+
+			tm := time.Date(2026, 3, 3, 14, 30, 0, 0, mustLoadLocation(t, tt.zone))
+			require.Equal(t, tt.want, ZoneName(tm))
+		})
+	}
+}
+
+func TestUTCOffset(t *testing.T) {
+	t.Parallel()
+
+	tm := time.Date(2026, 3, 3, 14, 30, 0, 0, time.UTC)
+
+	require.Equal(t, "UTC", UTCOffset(tm))
+	require.Equal(t, "UTC+3", UTCOffset(tm.In(time.FixedZone("", 3*3600))))
+	require.Equal(t, "UTC-3:30", UTCOffset(tm.In(time.FixedZone("", -3*3600-1800))))
+}
+
+func TestShortMYHMZone(t *testing.T) {
+	t.Parallel()
+
+	moscow := mustLoadLocation(t, "Europe/Moscow")
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	tm := time.Date(2026, 3, 3, 14, 30, 0, 0, moscow)
+
+	tests := []struct {
+		name string
+		opts ZoneOptions
+		want string
+	}{
+		{name: "abbreviation", want: "Mar 2026 14:30 MSK"},
+		{name: "offset", opts: ZoneOptions{Style: ZoneOffset}, want: "Mar 2026 14:30 UTC+3"},
+		{name: "both", opts: ZoneOptions{Style: ZoneAbbrevOffset}, want: "Mar 2026 14:30 MSK (UTC+3)"},
+		{name: "converted", opts: ZoneOptions{In: berlin}, want: "Mar 2026 12:30 CET"},
+		{
+			name: "your time",
+			opts: ZoneOptions{Style: ZoneYourTime, In: berlin},
+			want: "Mar 2026 14:30 MSK (12:30 your time)",
+		},
+		{
+			name: "your time on another day",
+			opts: ZoneOptions{Style: ZoneYourTime, In: mustLoadLocation(t, "Pacific/Auckland")},
+			want: "Mar 2026 14:30 MSK (4 Mar 00:30 your time)",
+		},
+		{
+			name: "your time in the same zone",
+			opts: ZoneOptions{Style: ZoneYourTime, In: moscow},
+			want: "Mar 2026 14:30 MSK",
+		},
+		{
+			name: "your time in russian",
+			opts: ZoneOptions{Style: ZoneYourTime, In: berlin, Locale: Russian},
+			want: "Mar 2026 14:30 MSK (12:30 по вашему времени)",
+		},
+		{
+			name: "your time on another day in russian",
+			opts: ZoneOptions{Style: ZoneYourTime, In: mustLoadLocation(t, "Pacific/Auckland"), Locale: Russian},
+			want: "Mar 2026 14:30 MSK (4 мар 00:30 по вашему времени)",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, ShortMYHMZone(tm, tt.opts))
+		})
+	}
+}
+
+func TestDynamicZone(t *testing.T) {
+	t.Parallel()
+
+	moscow := mustLoadLocation(t, "Europe/Moscow")
+	tm := time.Date(2026, 3, 3, 14, 30, 0, 0, moscow)
+
+	require.Equal(t, "5 minutes ago", dynamicZone(tm, tm.Add(5*time.Minute), ZoneOptions{}))
+	require.Equal(t, "today 14:30 MSK", dynamicZone(tm, tm.Add(2*time.Hour), ZoneOptions{}))
+	require.Equal(t, "yesterday 11:30 UTC", dynamicZone(tm, tm.Add(30*time.Hour), ZoneOptions{In: time.UTC}))
+	require.Equal(t, "03.03.2026 14:30 UTC+3", dynamicZone(tm, tm.Add(72*time.Hour), ZoneOptions{Style: ZoneOffset}))
+}
@@ -0,0 +1,50 @@
+package stringo
+
+import (
+	"iter"
+	"strings"
+
+	"github.com/sitnikovik/stringo/internal/grapheme"
+)
+
+// Graphemes returns an iterator over the extended grapheme clusters of s
+// as defined by Unicode UAX #29, i.e. over the user-perceived characters:
+// "e" followed by a combining acute accent, a flag or a family emoji
+// joined with ZWJ are single clusters.
+func Graphemes(s string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for s != "" {
+			n := grapheme.FirstLen(s)
+			if !yield(s[:n]) {
+				return
+			}
+			s = s[n:]
+		}
+	}
+}
+
+// GraphemeLen returns the number of extended grapheme clusters in s.
+func GraphemeLen(s string) int {
+	n := 0
+	for s != "" {
+		s = s[grapheme.FirstLen(s):]
+		n++
+	}
+	return n
+}
+
+// ReverseGraphemes returns s with the order of its grapheme clusters reversed,
+// so combining marks and emoji sequences stay intact.
+func ReverseGraphemes(s string) string {
+	var clusters []string
+	for g := range Graphemes(s) {
+		clusters = append(clusters, g)
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for i := len(clusters) - 1; i >= 0; i-- {
+		sb.WriteString(clusters[i])
+	}
+	return sb.String()
+}
@@ -0,0 +1,71 @@
+package stringo
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphemes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		s    string
+		want []string
+	}{
+		{name: "empty", s: "", want: nil},
+		{name: "ascii", s: "abc", want: []string{"a", "b", "c"}},
+		{name: "crlf", s: "a\r\nb\n\r", want: []string{"a", "\r\n", "b", "\n", "\r"}},
+		{name: "combining marks", s: "e\u0301cole", want: []string{"e\u0301", "c", "o", "l", "e"}},
+		{name: "multiple marks", s: "a\u0308\u0301b", want: []string{"a\u0308\u0301", "b"}},
+		{name: "mark after control", s: "\n\u0301", want: []string{"\n", "\u0301"}},
+		{name: "flags", s: "🇺🇸🇫🇷🇩", want: []string{"🇺🇸", "🇫🇷", "🇩"}},
+		{name: "skin tone", s: "👍🏽!", want: []string{"👍🏽", "!"}},
+		{name: "zwj family", s: "👨\u200d👩\u200d👧x", want: []string{"👨\u200d👩\u200d👧", "x"}},
+		{name: "zwj without emoji", s: "a\u200db", want: []string{"a\u200d", "b"}},
+		{name: "emoji presentation", s: "❤\ufe0f", want: []string{"❤\ufe0f"}},
+		{name: "tag sequence", s: "🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", want: []string{"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f"}},
+		{name: "hangul jamo", s: "\u1100\u1161\u11a8\uac00\u11a8", want: []string{"\u1100\u1161\u11a8", "\uac00\u11a8"}},
+		{name: "spacing mark", s: "क\u093f", want: []string{"क\u093f"}},
+		{name: "indic conjunct", s: "क\u094dष\u093f", want: []string{"क\u094dष\u093f"}},
+		{name: "prepend", s: "\u0600١", want: []string{"\u0600١"}},
+		{name: "thai sara am", s: "นำ", want: []string{"นำ"}},
+		{name: "invalid utf-8", s: "a\xff\u0301", want: []string{"a", "\xff\u0301"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := slices.Collect(Graphemes(tt.s))
+
+			require.Equal(t, tt.want, got)
+			require.Equal(t, len(tt.want), GraphemeLen(tt.s))
+		})
+	}
+}
+
+func TestReverseGraphemes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "", want: ""},
+		{s: "abc", want: "cba"},
+		{s: "e\u0301cole", want: "eloce\u0301"},
+		{s: "a🇺🇸b👨\u200d👩\u200d👧", want: "👨\u200d👩\u200d👧b🇺🇸a"},
+		{s: "привет", want: "тевирп"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, ReverseGraphemes(tt.s))
+		})
+	}
+}
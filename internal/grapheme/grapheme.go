@@ -0,0 +1,170 @@
+// Package grapheme implements extended grapheme cluster segmentation
+// as defined by Unicode UAX #29.
+package grapheme
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// graphemeClass is the Grapheme_Cluster_Break property of a rune.
+type graphemeClass int8
+
+const (
+	gcOther graphemeClass = iota
+	gcCR
+	gcLF
+	gcControl
+	gcExtend
+	gcZWJ
+	gcRegionalIndicator
+	gcPrepend
+	gcSpacingMark
+	gcL
+	gcV
+	gcT
+	gcLV
+	gcLVT
+)
+
+// FirstLen returns the byte length of the first extended grapheme cluster of s
+// as defined by Unicode UAX #29.
+func FirstLen(s string) int {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		return 0
+	}
+
+	prev := graphemeClassOf(r)
+	var (
+		regional = 0    // number of regional indicators in a row
+		emoji    = 0    // 1 after Extended_Pictographic Extend*, 2 after its ZWJ
+		conjunct = 0    // 1 after an Indic consonant, 2 after its linker
+		i        = size // end of the cluster
+	)
+	if prev == gcRegionalIndicator {
+		regional = 1
+	}
+	if unicode.Is(extendedPictographic, r) {
+		emoji = 1
+	}
+	if unicode.Is(conjunctConsonant, r) {
+		conjunct = 1
+	}
+
+	for i < len(s) {
+		r, size = utf8.DecodeRuneInString(s[i:])
+		cur := graphemeClassOf(r)
+		if !joinsGrapheme(prev, cur, r, regional, emoji, conjunct) {
+			break
+		}
+
+		switch {
+		case cur == gcRegionalIndicator:
+			regional++
+		default:
+			regional = 0
+		}
+		switch {
+		case unicode.Is(extendedPictographic, r):
+			emoji = 1
+		case emoji == 1 && cur == gcExtend:
+		case emoji == 1 && cur == gcZWJ:
+			emoji = 2
+		default:
+			emoji = 0
+		}
+		switch {
+		case unicode.Is(conjunctConsonant, r):
+			conjunct = 1
+		case conjunct > 0 && unicode.Is(conjunctLinker, r):
+			conjunct = 2
+		case conjunct > 0 && (cur == gcExtend || cur == gcZWJ):
+		default:
+			conjunct = 0
+		}
+
+		prev = cur
+		i += size
+	}
+	return i
+}
+
+// joinsGrapheme defines if there is no grapheme cluster break between
+// the runes of the classes prev and cur following the rules of UAX #29.
+func joinsGrapheme(prev, cur graphemeClass, r rune, regional, emoji, conjunct int) bool {
+	switch {
+	case prev == gcCR && cur == gcLF: // GB3
+		return true
+	case prev == gcControl || prev == gcCR || prev == gcLF: // GB4
+		return false
+	case cur == gcControl || cur == gcCR || cur == gcLF: // GB5
+		return false
+	case prev == gcL && (cur == gcL || cur == gcV || cur == gcLV || cur == gcLVT): // GB6
+		return true
+	case (prev == gcLV || prev == gcV) && (cur == gcV || cur == gcT): // GB7
+		return true
+	case (prev == gcLVT || prev == gcT) && cur == gcT: // GB8
+		return true
+	case cur == gcExtend || cur == gcZWJ: // GB9
+		return true
+	case cur == gcSpacingMark: // GB9a
+		return true
+	case prev == gcPrepend: // GB9b
+		return true
+	case conjunct == 2 && unicode.Is(conjunctConsonant, r): // GB9c
+		return true
+	case prev == gcZWJ && emoji == 2 && unicode.Is(extendedPictographic, r): // GB11
+		return true
+	case prev == gcRegionalIndicator && cur == gcRegionalIndicator: // GB12, GB13
+		return regional%2 == 1
+	}
+	return false // GB999
+}
+
+// graphemeClassOf returns the Grapheme_Cluster_Break property of the rune.
+func graphemeClassOf(r rune) graphemeClass {
+	switch {
+	case r == '\r':
+		return gcCR
+	case r == '\n':
+		return gcLF
+	case r == '\u200d':
+		return gcZWJ
+	case r == '\u200c':
+		return gcExtend
+	case r < 0x7f && r >= 0x20:
+		return gcOther
+	case r >= 0x1f1e6 && r <= 0x1f1ff:
+		return gcRegionalIndicator
+	case r >= 0x1f3fb && r <= 0x1f3ff: // emoji modifiers
+		return gcExtend
+	case r >= 0xac00 && r <= 0xd7a3:
+		if (r-0xac00)%28 == 0 {
+			return gcLV
+		}
+		return gcLVT
+	case r >= 0x1100 && r <= 0x115f, r >= 0xa960 && r <= 0xa97c:
+		return gcL
+	case r >= 0x1160 && r <= 0x11a7, r >= 0xd7b0 && r <= 0xd7c6:
+		return gcV
+	case r >= 0x11a8 && r <= 0x11ff, r >= 0xd7cb && r <= 0xd7fb:
+		return gcT
+	case unicode.Is(prepend, r):
+		return gcPrepend
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Other_Grapheme_Extend):
+		return gcExtend
+	case unicode.In(r, unicode.Cc, unicode.Zl, unicode.Zp, unicode.Cf):
+		return gcControl
+	case r == 0x0e33 || r == 0x0eb3:
+		return gcSpacingMark
+	case unicode.Is(unicode.Mc, r) && !unicode.Is(spacingMarkExceptions, r):
+		return gcSpacingMark
+	}
+	return gcOther
+}
+
+// IsExtendedPictographic defines if the rune has the Extended_Pictographic property.
+func IsExtendedPictographic(r rune) bool {
+	return unicode.Is(extendedPictographic, r)
+}
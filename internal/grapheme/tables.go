@@ -0,0 +1,184 @@
+package grapheme
+
+import "unicode"
+
+// The tables below complement the unicode package with the properties
+// used by grapheme cluster segmentation (UAX #29).
+
+// extendedPictographic is the Extended_Pictographic property from emoji-data.txt.
+var extendedPictographic = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x00a9, 0x00a9, 1},
+		{0x00ae, 0x00ae, 1},
+		{0x203c, 0x203c, 1},
+		{0x2049, 0x2049, 1},
+		{0x2122, 0x2122, 1},
+		{0x2139, 0x2139, 1},
+		{0x2194, 0x2199, 1},
+		{0x21a9, 0x21aa, 1},
+		{0x231a, 0x231b, 1},
+		{0x2328, 0x2328, 1},
+		{0x2388, 0x2388, 1},
+		{0x23cf, 0x23cf, 1},
+		{0x23e9, 0x23f3, 1},
+		{0x23f8, 0x23fa, 1},
+		{0x24c2, 0x24c2, 1},
+		{0x25aa, 0x25ab, 1},
+		{0x25b6, 0x25b6, 1},
+		{0x25c0, 0x25c0, 1},
+		{0x25fb, 0x25fe, 1},
+		{0x2600, 0x2605, 1},
+		{0x2607, 0x2612, 1},
+		{0x2614, 0x2685, 1},
+		{0x2690, 0x2705, 1},
+		{0x2708, 0x2712, 1},
+		{0x2714, 0x2714, 1},
+		{0x2716, 0x2716, 1},
+		{0x271d, 0x271d, 1},
+		{0x2721, 0x2721, 1},
+		{0x2728, 0x2728, 1},
+		{0x2733, 0x2734, 1},
+		{0x2744, 0x2744, 1},
+		{0x2747, 0x2747, 1},
+		{0x274c, 0x274c, 1},
+		{0x274e, 0x274e, 1},
+		{0x2753, 0x2755, 1},
+		{0x2757, 0x2757, 1},
+		{0x2763, 0x2767, 1},
+		{0x2795, 0x2797, 1},
+		{0x27a1, 0x27a1, 1},
+		{0x27b0, 0x27b0, 1},
+		{0x27bf, 0x27bf, 1},
+		{0x2934, 0x2935, 1},
+		{0x2b05, 0x2b07, 1},
+		{0x2b1b, 0x2b1c, 1},
+		{0x2b50, 0x2b50, 1},
+		{0x2b55, 0x2b55, 1},
+		{0x3030, 0x3030, 1},
+		{0x303d, 0x303d, 1},
+		{0x3297, 0x3297, 1},
+		{0x3299, 0x3299, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1f000, 0x1f0ff, 1},
+		{0x1f10d, 0x1f10f, 1},
+		{0x1f12f, 0x1f12f, 1},
+		{0x1f16c, 0x1f171, 1},
+		{0x1f17e, 0x1f17f, 1},
+		{0x1f18e, 0x1f18e, 1},
+		{0x1f191, 0x1f19a, 1},
+		{0x1f1ad, 0x1f1e5, 1},
+		{0x1f201, 0x1f20f, 1},
+		{0x1f21a, 0x1f21a, 1},
+		{0x1f22f, 0x1f22f, 1},
+		{0x1f232, 0x1f23a, 1},
+		{0x1f23c, 0x1f23f, 1},
+		{0x1f249, 0x1f3fa, 1},
+		{0x1f400, 0x1f53d, 1},
+		{0x1f546, 0x1f64f, 1},
+		{0x1f680, 0x1f6ff, 1},
+		{0x1f774, 0x1f77f, 1},
+		{0x1f7d5, 0x1f7ff, 1},
+		{0x1f80c, 0x1f80f, 1},
+		{0x1f848, 0x1f84f, 1},
+		{0x1f85a, 0x1f85f, 1},
+		{0x1f888, 0x1f88f, 1},
+		{0x1f8ae, 0x1f8ff, 1},
+		{0x1f90c, 0x1f93a, 1},
+		{0x1f93c, 0x1f945, 1},
+		{0x1f947, 0x1faff, 1},
+		{0x1fc00, 0x1fffd, 1},
+	},
+	LatinOffset: 2,
+}
+
+// prepend is Grapheme_Cluster_Break=Prepend: prepended concatenation marks
+// and consonants preceding repha or prefixed to the syllable.
+var prepend = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0600, 0x0605, 1},
+		{0x06dd, 0x06dd, 1},
+		{0x070f, 0x070f, 1},
+		{0x0890, 0x0891, 1},
+		{0x08e2, 0x08e2, 1},
+		{0x0d4e, 0x0d4e, 1},
+	},
+	R32: []unicode.Range32{
+		{0x110bd, 0x110bd, 1},
+		{0x110cd, 0x110cd, 1},
+		{0x111c2, 0x111c3, 1},
+		{0x113d1, 0x113d1, 1},
+		{0x1193f, 0x1193f, 1},
+		{0x11941, 0x11941, 1},
+		{0x11a3a, 0x11a3a, 1},
+		{0x11a84, 0x11a89, 1},
+		{0x11d46, 0x11d46, 1},
+		{0x11f02, 0x11f02, 1},
+	},
+}
+
+// spacingMarkExceptions are the spacing combining marks that do not
+// have Grapheme_Cluster_Break=SpacingMark.
+var spacingMarkExceptions = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x102b, 0x102c, 1},
+		{0x1038, 0x1038, 1},
+		{0x1062, 0x1064, 1},
+		{0x1067, 0x106d, 1},
+		{0x1083, 0x1083, 1},
+		{0x1087, 0x108c, 1},
+		{0x108f, 0x108f, 1},
+		{0x109a, 0x109c, 1},
+		{0x1a61, 0x1a61, 1},
+		{0x1a63, 0x1a64, 1},
+		{0xaa7b, 0xaa7b, 1},
+		{0xaa7d, 0xaa7d, 1},
+	},
+	R32: []unicode.Range32{
+		{0x11720, 0x11721, 1},
+	},
+}
+
+// conjunctConsonant is Indic_Conjunct_Break=Consonant.
+var conjunctConsonant = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0915, 0x0939, 1},
+		{0x0958, 0x095f, 1},
+		{0x0978, 0x097f, 1},
+		{0x0995, 0x09a8, 1},
+		{0x09aa, 0x09b0, 1},
+		{0x09b2, 0x09b2, 1},
+		{0x09b6, 0x09b9, 1},
+		{0x09dc, 0x09dd, 1},
+		{0x09df, 0x09df, 1},
+		{0x09f0, 0x09f1, 1},
+		{0x0a95, 0x0aa8, 1},
+		{0x0aaa, 0x0ab0, 1},
+		{0x0ab2, 0x0ab3, 1},
+		{0x0ab5, 0x0ab9, 1},
+		{0x0af9, 0x0af9, 1},
+		{0x0b15, 0x0b28, 1},
+		{0x0b2a, 0x0b30, 1},
+		{0x0b32, 0x0b33, 1},
+		{0x0b35, 0x0b39, 1},
+		{0x0b5c, 0x0b5d, 1},
+		{0x0b5f, 0x0b5f, 1},
+		{0x0b71, 0x0b71, 1},
+		{0x0c15, 0x0c28, 1},
+		{0x0c2a, 0x0c39, 1},
+		{0x0c58, 0x0c5a, 1},
+		{0x0d15, 0x0d3a, 1},
+	},
+}
+
+// conjunctLinker is Indic_Conjunct_Break=Linker: the viramas joining conjuncts.
+var conjunctLinker = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x094d, 0x094d, 1},
+		{0x09cd, 0x09cd, 1},
+		{0x0acd, 0x0acd, 1},
+		{0x0b4d, 0x0b4d, 1},
+		{0x0c4d, 0x0c4d, 1},
+		{0x0d4d, 0x0d4d, 1},
+	},
+}
@@ -0,0 +1,104 @@
+package stringo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ShellSplit splits the string into words following the POSIX sh quoting rules:
+// blanks separate words, a backslash escapes the next character, single quotes
+// keep everything literally, and inside double quotes a backslash escapes only
+// '$', '`', '"', '\' and a newline. A backslash followed by a newline joins lines.
+// No expansions are performed and comments and operators are not recognised,
+// so "$HOME" stays as is. The error wraps ErrUnbalanced.
+func ShellSplit(s string) ([]string, error) {
+	var (
+		words  []string
+		word   strings.Builder
+		inWord bool
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case ' ', '\t', '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		case '\\':
+			if i+1 == len(s) {
+				return nil, fmt.Errorf("%w: dangling escape at %d", ErrUnbalanced, i)
+			}
+			i++
+			if s[i] != '\n' {
+				word.WriteByte(s[i])
+				inWord = true
+			}
+		case '\'':
+			end := strings.IndexByte(s[i+1:], '\'')
+			if end < 0 {
+				return nil, fmt.Errorf("%w: unterminated single quote at %d", ErrUnbalanced, i)
+			}
+			word.WriteString(s[i+1 : i+1+end])
+			i += end + 1
+			inWord = true
+		case '"':
+			start := i
+			for i++; ; i++ {
+				if i == len(s) {
+					return nil, fmt.Errorf("%w: unterminated double quote at %d", ErrUnbalanced, start)
+				}
+				if s[i] == '"' {
+					break
+				}
+				if s[i] == '\\' && i+1 < len(s) && strings.IndexByte("$`\"\\\n", s[i+1]) >= 0 {
+					i++
+					if s[i] == '\n' {
+						continue
+					}
+				}
+				word.WriteByte(s[i])
+			}
+			inWord = true
+		default:
+			word.WriteByte(c)
+			inWord = true
+		}
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// ShellQuote returns the arguments joined by spaces and quoted for POSIX sh
+// so that ShellSplit returns them back. Arguments of safe characters only
+// are left as is, others are enclosed in single quotes.
+func ShellQuote(args ...string) string {
+	quoted := make([]string, 0, len(args))
+	for _, arg := range args {
+		quoted = append(quoted, shellQuoteWord(arg))
+	}
+	return strings.Join(quoted, " ")
+}
+
+// shellQuoteWord quotes a single argument.
+func shellQuoteWord(s string) string {
+	if s == "" {
+		return "''"
+	}
+	if strings.IndexFunc(s, func(r rune) bool { return !isShellSafe(r) }) < 0 {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// isShellSafe defines if the rune needs no quoting in sh.
+func isShellSafe(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return true
+	}
+	return strings.ContainsRune("@%+=:,./_-", r)
+}
@@ -0,0 +1,102 @@
+package stringo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShellSplit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		s       string
+		want    []string
+		wantErr string
+	}{
+		{name: "empty", s: "", want: nil},
+		{name: "blanks only", s: " \t\n ", want: nil},
+		{name: "words", s: "  ls  -la\t/tmp \n", want: []string{"ls", "-la", "/tmp"}},
+		{name: "single quotes", s: `echo 'hello   world' '\n' 'a"b'`, want: []string{"echo", "hello   world", `\n`, `a"b`}},
+		{name: "double quotes", s: `echo "a  b" "it's" "x\"y" "\$HOME" "\a" "\\"`, want: []string{"echo", "a  b", "it's", `x"y`, "$HOME", `\a`, `\`}},
+		{name: "no expansion", s: `echo $HOME "$(id)" ~ *`, want: []string{"echo", "$HOME", "$(id)", "~", "*"}},
+		{name: "backslash escapes", s: `a\ b \'c\' d\\e \x`, want: []string{"a b", "'c'", `d\e`, "x"}},
+		{name: "adjacent quoting joins", s: `foo"bar"'baz'qux`, want: []string{"foobarbazqux"}},
+		{name: "empty quoted words", s: `'' "" a''`, want: []string{"", "", "a"}},
+		{name: "line continuation", s: "a\\\nb \"c\\\nd\"", want: []string{"ab", "cd"}},
+		{name: "escaped quote closes single quote", s: `'it'\''s'`, want: []string{"it's"}},
+		{name: "unicode", s: `привет "мир 🌍"`, want: []string{"привет", "мир 🌍"}},
+		{name: "unterminated single quote", s: `echo 'abc`, wantErr: "stringo: unbalanced input: unterminated single quote at 5"},
+		{name: "unterminated double quote", s: `echo "abc\"`, wantErr: "stringo: unbalanced input: unterminated double quote at 5"},
+		{name: "dangling escape", s: `echo abc\`, wantErr: "stringo: unbalanced input: dangling escape at 8"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ShellSplit(tt.s)
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, ErrUnbalanced)
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestShellQuote(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "no args", args: nil, want: ""},
+		{name: "safe", args: []string{"ls", "-la", "/tmp/a_b.txt", "key=value"}, want: "ls -la /tmp/a_b.txt key=value"},
+		{name: "empty", args: []string{""}, want: "''"},
+		{name: "spaces", args: []string{"hello world"}, want: "'hello world'"},
+		{name: "single quote", args: []string{"it's"}, want: `'it'\''s'`},
+		{name: "specials", args: []string{"$HOME", "a;b", "*"}, want: `'$HOME' 'a;b' '*'`},
+		{name: "unicode", args: []string{"мир"}, want: "'мир'"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, ShellQuote(tt.args...))
+		})
+	}
+}
+
+func FuzzShellQuote(f *testing.F) {
+	f.Add("hello", "world")
+	f.Add("", "it's")
+	f.Add("a b\tc\nd", `"\$`)
+	f.Add("\xff", "'''")
+	f.Fuzz(func(t *testing.T, a, b string) {
+		got, err := ShellSplit(ShellQuote(a, b))
+		require.NoError(t, err)
+		require.Equal(t, []string{a, b}, got)
+	})
+}
+
+func FuzzShellSplit(f *testing.F) {
+	f.Add(`echo "a b" 'c' d\ e`)
+	f.Add(`"\`)
+	f.Fuzz(func(t *testing.T, s string) {
+		words, err := ShellSplit(s)
+		if err != nil {
+			require.ErrorIs(t, err, ErrUnbalanced)
+			return
+		}
+		again, err := ShellSplit(ShellQuote(words...))
+		require.NoError(t, err)
+		require.Equal(t, words, again)
+	})
+}
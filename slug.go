@@ -0,0 +1,254 @@
+package stringo
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/sitnikovik/stringo/cases"
+	"github.com/sitnikovik/stringo/translit"
+)
+
+// SlugOptions describes how Slugify builds a slug.
+type SlugOptions struct {
+	// Separator joins the words, "-" by default.
+	Separator string
+	// MaxLength is the maximum number of characters in the slug. Whole words
+	// are dropped from the end to fit, and the only word is cut if it is longer.
+	// Zero means no limit.
+	MaxLength int
+	// StopWords are removed from the slug unless it consists of them only.
+	// They are compared ignoring case after transliteration.
+	StopWords []string
+	// Translit adds or overrides transliterations of lowercase runes.
+	Translit map[rune]string
+}
+
+// Slugify turns s into a lowercase URL slug like "privet-mir" for "Привет мир".
+// Cyrillic is transliterated with translit.GOST779 dropping its diacritical
+// signs, Greek with ELOT 743 including its digraphs like "ου" and "μπ" and
+// ignoring accents and breathings, German umlauts and ß are expanded, and other
+// Latin letters lose their diacritics. Letters of other scripts are kept
+// and everything but letters and digits separates the words, which are
+// joined like cases.ToKebabCase does.
+func Slugify(s string, opts SlugOptions) string {
+	sep := opts.Separator
+	if sep == "" {
+		sep = "-"
+	}
+
+	words := cases.SplitToWords(slugTranslit(s, opts.Translit))
+	if len(opts.StopWords) > 0 {
+		stop := make(map[string]bool, len(opts.StopWords))
+		for _, w := range opts.StopWords {
+			stop[slugTranslit(w, opts.Translit)] = true
+		}
+		kept := make([]string, 0, len(words))
+		for _, w := range words {
+			if !stop[w] {
+				kept = append(kept, w)
+			}
+		}
+		if len(kept) > 0 {
+			words = kept
+		}
+	}
+	if opts.MaxLength > 0 {
+		words = slugCut(words, opts.MaxLength, utf8.RuneCountInString(sep))
+	}
+
+	slug := cases.ToKebabCase(strings.Join(words, " "))
+	if sep != "-" {
+		slug = strings.ReplaceAll(slug, "-", sep)
+	}
+	return slug
+}
+
+// slugTranslit lowercases and transliterates s dropping combining marks and apostrophes.
+func slugTranslit(s string, custom map[rune]string) string {
+	var sb strings.Builder
+	sb.Grow(len(s))
+	runes := slugFold([]rune(strings.ToLower(s)), custom)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if t, ok := custom[r]; ok {
+			sb.WriteString(t)
+			continue
+		}
+		if t, n := elotDigraph(runes, i); n > 0 {
+			sb.WriteString(t)
+			i += n - 1
+			continue
+		}
+		if n := slugCyrillic(runes[i:], custom); n > 0 {
+			sb.WriteString(gostSigns.Replace(translit.To(string(runes[i:i+n]), translit.GOST779)))
+			i += n - 1
+			continue
+		}
+		switch t, ok := slugRunes[r]; {
+		case r == '\'' || r == '’':
+		case ok:
+			sb.WriteString(t)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// slugCyrillic returns the number of Cyrillic letters at the start of runes
+// without custom transliterations.
+func slugCyrillic(runes []rune, custom map[rune]string) int {
+	for i, r := range runes {
+		if _, ok := custom[r]; ok || !unicode.Is(unicode.Cyrillic, r) {
+			return i
+		}
+	}
+	return len(runes)
+}
+
+// slugFold drops combining marks and turns accented Greek letters into plain ones
+// keeping the diaeresis, which separates the vowels of ELOT 743 digraphs.
+// The runes with custom transliterations are kept as is.
+func slugFold(runes []rune, custom map[rune]string) []rune {
+	folded := runes[:0]
+	for _, r := range runes {
+		if _, ok := custom[r]; ok {
+			folded = append(folded, r)
+			continue
+		}
+		switch last := len(folded) - 1; {
+		case unicode.Is(unicode.Mn, r):
+			if r == '\u0308' && last >= 0 && greekDiaeresis[folded[last]] != 0 {
+				folded[last] = greekDiaeresis[folded[last]]
+			}
+		case greekFold[r] != 0:
+			folded = append(folded, greekFold[r])
+		default:
+			folded = append(folded, r)
+		}
+	}
+	return folded
+}
+
+// elotDigraph returns the ELOT 743 transliteration of the Greek digraph
+// at runes[i] and the number of runes it takes, zero if there is none.
+func elotDigraph(runes []rune, i int) (string, int) {
+	at := func(j int) rune {
+		if j < 0 || j >= len(runes) {
+			return 0
+		}
+		return runes[j]
+	}
+
+	r, next := runes[i], at(i+1)
+	switch {
+	case r == 'ο' && next == 'υ':
+		return "ou", 2
+	case (r == 'α' || r == 'ε' || r == 'η') && next == 'υ':
+		// "v" before vowels and voiced consonants, "f" elsewhere.
+		if strings.ContainsRune("αεηιουωϊϋβγδζλμνρ", at(i+2)) {
+			return slugRunes[r] + "v", 2
+		}
+		return slugRunes[r] + "f", 2
+	case r == 'γ' && next == 'κ':
+		return "gk", 2
+	case r == 'γ' && (next == 'γ' || next == 'ξ' || next == 'χ'):
+		return "n" + slugRunes[next], 2
+	case r == 'μ' && next == 'π':
+		// "b" at the edges of a word, "mb" inside it.
+		if !isGreekLetter(at(i-1)) || !isGreekLetter(at(i+2)) {
+			return "b", 2
+		}
+		return "mb", 2
+	}
+	return "", 0
+}
+
+// isGreekLetter defines if the rune is a Greek letter.
+func isGreekLetter(r rune) bool {
+	return unicode.Is(unicode.Greek, r) && unicode.IsLetter(r)
+}
+
+// slugCut drops words from the end until the slug fits in limit characters.
+func slugCut(words []string, limit, sepLen int) []string {
+	n := 0
+	for i, w := range words {
+		l := utf8.RuneCountInString(w)
+		if i > 0 {
+			l += sepLen
+		}
+		if n+l > limit {
+			if i == 0 {
+				return []string{string([]rune(w)[:limit])}
+			}
+			return words[:i]
+		}
+		n += l
+	}
+	return words
+}
+
+// slugRunes maps lowercase runes to their transliterations.
+var slugRunes = expandRunes(map[string]string{
+	// Greek, ELOT 743, after slugFold; digraphs are handled by elotDigraph.
+	"α": "a", "β": "v", "γ": "g", "δ": "d", "ε": "e", "ζ": "z", "η": "i",
+	"θ": "th", "ιϊ": "i", "κ": "k", "λ": "l", "μ": "m", "ν": "n", "ξ": "x",
+	"ο": "o", "π": "p", "ρ": "r", "σς": "s", "τ": "t", "υϋ": "y", "φ": "f",
+	"χ": "ch", "ψ": "ps", "ω": "o",
+	// German.
+	"ä": "ae", "ö": "oe", "ü": "ue", "ß": "ss",
+	// Latin-1 Supplement, Latin Extended-A and -B and Latin Extended Additional,
+	// the letters decomposing into a base letter and marks and those with
+	// strokes and hooks.
+	"àáâãåāăąǎǟǡǻȁȃȧḁạảấầẩẫậắằẳẵặ": "a", "æǣǽ": "ae", "ƀḃḅḇ": "b",
+	"çćĉċčƈḉ": "c", "ďđðƌɗȡḋḍḏḑḓ": "d", "ǆǳ": "dz",
+	"èéêëēĕėęěȅȇȩɇḕḗḙḛḝẹẻẽếềểễệ": "e", "ƒḟ": "f", "ĝğġģǥǧǵɠḡ": "g",
+	"ĥħȟḣḥḧḩḫẖ": "h", "ìíîïĩīĭįıǐȉȋḭḯỉị": "i", "ĳ": "ij", "ĵǰɉ": "j",
+	"ķƙǩḱḳḵ": "k", "ĺļľŀłƚȴḷḹḻḽ": "l", "ǉ": "lj", "ḿṁṃ": "m",
+	"ñńņňŉŋƞǹȵṅṇṉṋ": "n", "ǌ": "nj",
+	"òóôõøōŏőơǒǫǭǿȍȏȫȭȯȱṍṏṑṓọỏốồổỗộớờởỡợ": "o", "œ": "oe", "ƥṕṗ": "p",
+	"ŕŗřȑȓɍṙṛṝṟ": "r", "śŝşšſșṡṣṥṧṩẛ": "s", "ţťŧƭțȶṫṭṯṱẗ": "t", "þ": "th",
+	"ùúûũūŭůűųưǔǖǘǚǜȕȗṳṵṷṹṻụủứừửữự": "u", "ʋṽṿ": "v", "ŵẁẃẅẇẉẘ": "w",
+	"ẋẍ": "x", "ýÿŷƴȳɏẏẙỳỵỷỹ": "y", "źżžƶȥẑẓẕ": "z",
+})
+
+// gostSigns drops the diacritical signs of GOST 7.79 system B.
+var gostSigns = strings.NewReplacer("`", "", "'", "")
+
+// greekFold maps the lowercase Greek letters with accents, breathings and
+// iota subscripts to the plain ones, keeping the diaeresis.
+var greekFold = func() map[rune]rune {
+	m := make(map[rune]rune)
+	for runes, plain := range map[string]rune{
+		"άἀἁἂἃἄἅἆἇὰάᾀᾁᾂᾃᾄᾅᾆᾇᾰᾱᾲᾳᾴᾶᾷ": 'α',
+		"έἐἑἒἓἔἕὲέ": 'ε',
+		"ήἠἡἢἣἤἥἦἧὴήᾐᾑᾒᾓᾔᾕᾖᾗῂῃῄῆῇ": 'η',
+		"ίἰἱἲἳἴἵἶἷὶίιῐῑῖ":          'ι',
+		"όὀὁὂὃὄὅὸό":                'ο',
+		"ῤῥ":                       'ρ',
+		"ύὐὑὒὓὔὕὖὗὺύῠῡῦ":           'υ',
+		"ώὠὡὢὣὤὥὦὧὼώᾠᾡᾢᾣᾤᾥᾦᾧῲῳῴῶῷ": 'ω',
+		"ΐῒΐῗ": 'ϊ',
+		"ΰῢΰῧ": 'ϋ',
+	} {
+		for _, r := range runes {
+			m[r] = plain
+		}
+	}
+	return m
+}()
+
+// greekDiaeresis maps the Greek vowels taking a diaeresis to the letters with it.
+var greekDiaeresis = map[rune]rune{'ι': 'ϊ', 'υ': 'ϋ'}
+
+// expandRunes turns a map of rune groups into a map of runes.
+func expandRunes(groups map[string]string) map[rune]string {
+	m := make(map[rune]string)
+	for runes, t := range groups {
+		for _, r := range runes {
+			m[r] = t
+		}
+	}
+	return m
+}
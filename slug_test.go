@@ -0,0 +1,60 @@
+package stringo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlugify(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		s    string
+		opts SlugOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{name: "empty", args: args{s: ""}, want: ""},
+		{name: "latin", args: args{s: "Hello, World!"}, want: "hello-world"},
+		{name: "cyrillic", args: args{s: "Привет мир"}, want: "privet-mir"},
+		{name: "cyrillic gost", args: args{s: "Щука, цирк и лицо: съешь же ещё"}, want: "shhuka-cirk-i-liczo-sesh-zhe-eshhyo"},
+		{name: "ukrainian", args: args{s: "Їжак і ґанок"}, want: "yizhak-i-ganok"},
+		{name: "belarusian and macedonian", args: args{s: "Ўладзімір, Љубов Џеф"}, want: "uladzimir-lubov-dhef"},
+		{name: "greek", args: args{s: "Καλημέρα κόσμε"}, want: "kalimera-kosme"},
+		{name: "greek digraphs", args: args{s: "Ευρώπη Κουκου"}, want: "evropi-koukou"},
+		{name: "greek av and af", args: args{s: "Αυγή αυτός ευχή"}, want: "avgi-aftos-efchi"},
+		{name: "greek gamma digraphs", args: args{s: "Άγγελος σύγκριση Σφίγξ"}, want: "angelos-sygkrisi-sfinx"},
+		{name: "greek mp", args: args{s: "Μπαμπάς Λάμπ"}, want: "bambas-lab"},
+		{name: "greek diaeresis", args: args{s: "Ευλογία αϋπνία"}, want: "evlogia-aypnia"},
+		{name: "greek combining diaeresis", args: args{s: "αυ\u0308πνία"}, want: "aypnia"},
+		{name: "greek polytonic", args: args{s: "Ὀδυσσεύς ᾠδή"}, want: "odyssefs-odi"},
+		{name: "german", args: args{s: "Größe über Äpfel"}, want: "groesse-ueber-aepfel"},
+		{name: "diacritics", args: args{s: "Crème brûlée à la française"}, want: "creme-brulee-a-la-francaise"},
+		{name: "vietnamese", args: args{s: "Tiếng Việt của Đặng"}, want: "tieng-viet-cua-dang"},
+		{name: "pinyin", args: args{s: "Nǐ hǎo, lǜ"}, want: "ni-hao-lu"},
+		{name: "latin extended", args: args{s: "Ǆuro Ƀ ȘȚ ǿ ẞ"}, want: "dzuro-b-st-o-ss"},
+		{name: "combining marks", args: args{s: "Cafe\u0301"}, want: "cafe"},
+		{name: "apostrophes", args: args{s: "Don't stop — it’s 2026"}, want: "dont-stop-its-2026"},
+		{name: "other scripts kept", args: args{s: "Go 日本"}, want: "go-日本"},
+		{name: "separator", args: args{s: "Привет мир", opts: SlugOptions{Separator: "_"}}, want: "privet_mir"},
+		{name: "stop words", args: args{s: "The Lord of the Rings", opts: SlugOptions{StopWords: []string{"the", "of"}}}, want: "lord-rings"},
+		{name: "only stop words", args: args{s: "The Who", opts: SlugOptions{StopWords: []string{"the", "who"}}}, want: "the-who"},
+		{name: "cyrillic stop words", args: args{s: "Война и мир", opts: SlugOptions{StopWords: []string{"и"}}}, want: "vojna-mir"},
+		{name: "max length at word boundary", args: args{s: "the quick brown fox", opts: SlugOptions{MaxLength: 14}}, want: "the-quick"},
+		{name: "max length exact", args: args{s: "the quick brown fox", opts: SlugOptions{MaxLength: 15}}, want: "the-quick-brown"},
+		{name: "max length long word", args: args{s: "supercalifragilistic word", opts: SlugOptions{MaxLength: 5}}, want: "super"},
+		{name: "custom translit", args: args{s: "Хорошо", opts: SlugOptions{Translit: map[rune]string{'х': "kh"}}}, want: "khorosho"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, Slugify(tt.args.s, tt.args.opts))
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package stringo
+
+import (
+	"iter"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// SepPlacement describes what happens to the separators found by SplitFuncWith.
+type SepPlacement int8
+
+const (
+	// SepDrop describes separators removed from the parts, the default
+	SepDrop SepPlacement = iota + 1
+	// SepPrev describes separators attached to the end of the previous part
+	SepPrev
+	// SepNext describes separators attached to the start of the next part
+	SepNext
+	// SepOwn describes separators emitted as parts of their own
+	SepOwn
+)
+
+// SplitOptions describes how SplitFuncWith splits a string.
+type SplitOptions struct {
+	// Sep defines where separators go, SepDrop by default.
+	Sep SepPlacement
+	// OmitEmpty skips empty parts, after trimming if TrimSpace is set.
+	OmitEmpty bool
+	// TrimSpace trims leading and trailing white space of every part.
+	TrimSpace bool
+	// N is the maximum number of parts like in strings.SplitN:
+	// the last part is the unsplit remainder. Zero or less means no limit.
+	// With OmitEmpty and dropped separators the empty fields at the start
+	// of the remainder are skipped too.
+	N int
+}
+
+// SplitFuncWith splits string by condition with the given options.
+// Unlike SplitFunc it keeps the empty part after a trailing separator,
+// so "a,b," split by commas gives "a", "b" and "" unless OmitEmpty is set.
+func SplitFuncWith(s string, cond func(rune, int) bool, opts SplitOptions) []string {
+	return slices.Collect(SplitSeqWith(s, cond, opts))
+}
+
+// SplitSeqWith returns an iterator over the parts of SplitFuncWith.
+// The parts are substrings of s.
+func SplitSeqWith(s string, cond func(rune, int) bool, opts SplitOptions) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		n := 0
+		emit := func(part string) bool {
+			if opts.TrimSpace {
+				part = strings.TrimSpace(part)
+			}
+			if opts.OmitEmpty && part == "" {
+				return true
+			}
+			n++
+			return yield(part)
+		}
+		limited := func() bool {
+			return opts.N > 0 && n >= opts.N-1
+		}
+
+		start := 0
+	scan:
+		for i, r := range s {
+			if cond == nil || limited() {
+				break
+			}
+			if !cond(r, i) {
+				continue
+			}
+			_, size := utf8.DecodeRuneInString(s[i:])
+			end := i + size
+
+			switch opts.Sep {
+			case SepPrev:
+				if !emit(s[start:end]) {
+					return
+				}
+				start = end
+			case SepNext:
+				if !emit(s[start:i]) {
+					return
+				}
+				start = i
+			case SepOwn:
+				if !emit(s[start:i]) {
+					return
+				}
+				if limited() {
+					start = i
+					break scan
+				}
+				if !emit(s[i:end]) {
+					return
+				}
+				start = end
+			default:
+				if !emit(s[start:i]) {
+					return
+				}
+				start = end
+			}
+		}
+		if opts.OmitEmpty && limited() && cond != nil && (opts.Sep == 0 || opts.Sep == SepDrop) {
+			start = skipEmptyFields(s, start, cond, opts.TrimSpace)
+		}
+		emit(s[start:])
+	}
+}
+
+// skipEmptyFields returns the start of the first non-empty field of s from start on.
+func skipEmptyFields(s string, start int, cond func(rune, int) bool, trim bool) int {
+	for i := start; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if cond(r, i) {
+			field := s[start:i]
+			if trim {
+				field = strings.TrimSpace(field)
+			}
+			if field != "" {
+				return start
+			}
+			start = i + size
+		}
+		i += size
+	}
+	return start
+}
@@ -0,0 +1,128 @@
+package stringo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitFuncWith(t *testing.T) {
+	t.Parallel()
+
+	isComma := func(r rune, _ int) bool { return r == ',' }
+	type args struct {
+		s    string
+		cond func(rune, int) bool
+		opts SplitOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "nil func provided",
+			args: args{s: " a,b "},
+			want: []string{" a,b "},
+		},
+		{
+			name: "drop separators",
+			args: args{s: "a,b,,c,", cond: isComma},
+			want: []string{"a", "b", "", "c", ""},
+		},
+		{
+			name: "empty string",
+			args: args{s: "", cond: isComma},
+			want: []string{""},
+		},
+		{
+			name: "omit empty",
+			args: args{s: ",a,b,,c,", cond: isComma, opts: SplitOptions{OmitEmpty: true}},
+			want: []string{"a", "b", "c"},
+		},
+		{
+			name: "trim space and omit empty",
+			args: args{s: " a , b ,  , c", cond: isComma, opts: SplitOptions{TrimSpace: true, OmitEmpty: true}},
+			want: []string{"a", "b", "c"},
+		},
+		{
+			name: "attach to previous",
+			args: args{s: "a,b,", cond: isComma, opts: SplitOptions{Sep: SepPrev}},
+			want: []string{"a,", "b,", ""},
+		},
+		{
+			name: "attach to next",
+			args: args{s: "a,b,", cond: isComma, opts: SplitOptions{Sep: SepNext}},
+			want: []string{"a", ",b", ","},
+		},
+		{
+			name: "own token",
+			args: args{s: "a+b-c", cond: func(r rune, _ int) bool { return r == '+' || r == '-' }, opts: SplitOptions{Sep: SepOwn}},
+			want: []string{"a", "+", "b", "-", "c"},
+		},
+		{
+			name: "own token omit empty",
+			args: args{s: ",,a", cond: isComma, opts: SplitOptions{Sep: SepOwn, OmitEmpty: true}},
+			want: []string{",", ",", "a"},
+		},
+		{
+			name: "limit",
+			args: args{s: "a,b,c,d", cond: isComma, opts: SplitOptions{N: 2}},
+			want: []string{"a", "b,c,d"},
+		},
+		{
+			name: "limit one",
+			args: args{s: "a,b", cond: isComma, opts: SplitOptions{N: 1}},
+			want: []string{"a,b"},
+		},
+		{
+			name: "limit counts non-empty parts",
+			args: args{s: ",,a,,b,c", cond: isComma, opts: SplitOptions{N: 2, OmitEmpty: true}},
+			want: []string{"a", "b,c"},
+		},
+		{
+			name: "limit skips empty fields of the remainder",
+			args: args{s: "a, ,,b, c", cond: isComma, opts: SplitOptions{N: 2, OmitEmpty: true, TrimSpace: true}},
+			want: []string{"a", "b, c"},
+		},
+		{
+			name: "limit with only empty fields left",
+			args: args{s: "a,,", cond: isComma, opts: SplitOptions{N: 2, OmitEmpty: true}},
+			want: []string{"a"},
+		},
+		{
+			name: "limit with own token",
+			args: args{s: "a,b,c", cond: isComma, opts: SplitOptions{N: 2, Sep: SepOwn}},
+			want: []string{"a", ",b,c"},
+		},
+		{
+			name: "multibyte separator",
+			args: args{s: "a→b→c", cond: func(r rune, _ int) bool { return r == '→' }, opts: SplitOptions{Sep: SepPrev}},
+			want: []string{"a→", "b→", "c"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := SplitFuncWith(tt.args.s, tt.args.cond, tt.args.opts)
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSplitSeqWith_Break(t *testing.T) {
+	t.Parallel()
+
+	var got []string
+	for part := range SplitSeqWith("a,b,c", func(r rune, _ int) bool { return r == ',' }, SplitOptions{Sep: SepOwn}) {
+		got = append(got, part)
+		if len(got) == 2 {
+			break
+		}
+	}
+
+	require.Equal(t, []string{"a", ","}, got)
+}
@@ -0,0 +1,97 @@
+package stringo
+
+import (
+	"bufio"
+	"io"
+	"iter"
+	"unicode/utf8"
+)
+
+// Splitter splits a stream by condition like SplitFunc does with strings.
+// It is a bufio.Scanner, so tokens longer than bufio.MaxScanTokenSize
+// need a larger buffer set with Buffer before the first Scan.
+type Splitter struct {
+	*bufio.Scanner
+	split *runeSplitter
+}
+
+// NewSplitter returns a Splitter reading from r. The rune offset from the start
+// of the stream is passed to cond, unlike SplitFunc that passes the byte index.
+// Separators are dropped and a trailing empty part is not emitted, as in SplitFunc
+// without incSep. A nil cond makes the whole stream a single token.
+func NewSplitter(r io.Reader, cond func(rune, int) bool) *Splitter {
+	split := &runeSplitter{cond: cond}
+	sc := bufio.NewScanner(r)
+	sc.Split(split.split)
+	return &Splitter{Scanner: sc, split: split}
+}
+
+// Offset returns the rune offset of the current token from the start of the stream.
+func (s *Splitter) Offset() int {
+	return s.split.start
+}
+
+// All returns an iterator over the rest of the tokens and their rune offsets.
+// Check Err after the iteration.
+func (s *Splitter) All() iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		for s.Scan() {
+			if !yield(s.Offset(), s.Text()) {
+				return
+			}
+		}
+	}
+}
+
+// ScanFunc returns a bufio.SplitFunc splitting by condition for bufio.Scanner.
+// The rune offset from the start of the scanned stream is passed to cond.
+// The returned function keeps the offset, so use it with a single Scanner.
+func ScanFunc(cond func(rune, int) bool) bufio.SplitFunc {
+	split := &runeSplitter{cond: cond}
+	return split.split
+}
+
+// runeSplitter keeps the state of a bufio.SplitFunc between calls.
+type runeSplitter struct {
+	cond func(rune, int) bool
+	// offset is the rune offset of the unconsumed data.
+	offset int
+	// scanned and scannedRunes tell how much of the unconsumed data was checked
+	// so cond is called once per rune however the buffer grows.
+	scanned      int
+	scannedRunes int
+	// start is the rune offset of the last token.
+	start int
+}
+
+// split implements bufio.SplitFunc.
+func (s *runeSplitter) split(data []byte, atEOF bool) (int, []byte, error) {
+	for s.cond != nil && s.scanned < len(data) {
+		if !atEOF && !utf8.FullRune(data[s.scanned:]) {
+			return 0, nil, nil
+		}
+		r, size := utf8.DecodeRune(data[s.scanned:])
+		i, pos := s.scanned, s.offset+s.scannedRunes
+		s.scanned += size
+		s.scannedRunes++
+		if s.cond(r, pos) {
+			s.consume()
+			return i + size, data[:i], nil
+		}
+	}
+	if atEOF && len(data) > 0 {
+		if s.cond == nil {
+			s.scannedRunes = utf8.RuneCount(data)
+		}
+		s.consume()
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// consume moves the offset past the checked data.
+func (s *runeSplitter) consume() {
+	s.start = s.offset
+	s.offset += s.scannedRunes
+	s.scanned, s.scannedRunes = 0, 0
+}
@@ -0,0 +1,98 @@
+package stringo
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"unicode"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitter(t *testing.T) {
+	t.Parallel()
+
+	isSpace := func(r rune, _ int) bool { return unicode.IsSpace(r) }
+	tests := []struct {
+		name string
+		s    string
+		cond func(rune, int) bool
+	}{
+		{name: "nil func provided", s: "hello world"},
+		{name: "empty", s: "", cond: isSpace},
+		{name: "split by space", s: "hello world", cond: isSpace},
+		{name: "consecutive separators", s: " a  b ", cond: isSpace},
+		{name: "multibyte", s: "привет мир 🌍 ok", cond: isSpace},
+		{name: "multibyte separator", s: "a→b→→c", cond: func(r rune, _ int) bool { return r == '→' }},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			want := SplitFunc(tt.s, tt.cond, false)
+			if tt.s == "" {
+				want = nil
+			}
+			var got []string
+			sp := NewSplitter(iotest.OneByteReader(strings.NewReader(tt.s)), tt.cond)
+			for sp.Scan() {
+				got = append(got, sp.Text())
+			}
+
+			require.NoError(t, sp.Err())
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestSplitter_Offsets(t *testing.T) {
+	t.Parallel()
+
+	s := "при вет,мир"
+	var seen []int
+	cond := func(r rune, i int) bool {
+		seen = append(seen, i)
+		return r == ' ' || r == ','
+	}
+	sp := NewSplitter(iotest.HalfReader(strings.NewReader(s)), cond)
+	sp.Buffer(make([]byte, 2), 64)
+
+	var offsets []int
+	var tokens []string
+	for offset, token := range sp.All() {
+		offsets = append(offsets, offset)
+		tokens = append(tokens, token)
+	}
+
+	require.NoError(t, sp.Err())
+	require.Equal(t, []string{"при", "вет", "мир"}, tokens)
+	require.Equal(t, []int{0, 4, 8}, offsets)
+	require.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, seen)
+}
+
+func TestScanFunc(t *testing.T) {
+	t.Parallel()
+
+	sc := bufio.NewScanner(strings.NewReader("a\xffb;c;"))
+	sc.Split(ScanFunc(func(r rune, i int) bool { return r == ';' || r == unicode.ReplacementChar }))
+
+	var got []string
+	for sc.Scan() {
+		got = append(got, sc.Text())
+	}
+
+	require.NoError(t, sc.Err())
+	require.Equal(t, []string{"a", "b", "c"}, got)
+}
+
+func TestSplitter_TokenTooLong(t *testing.T) {
+	t.Parallel()
+
+	sp := NewSplitter(strings.NewReader(strings.Repeat("x", 100)), func(r rune, _ int) bool { return r == ' ' })
+	sp.Buffer(make([]byte, 8), 16)
+
+	require.False(t, sp.Scan())
+	require.ErrorIs(t, sp.Err(), bufio.ErrTooLong)
+}
@@ -0,0 +1,124 @@
+package translit
+
+import (
+	"strings"
+	"unicode"
+)
+
+// GOST779 is GOST 7.79-2000 system B (ISO 9:1995 without diacritics) for Russian.
+// It also transliterates the Ukrainian, Belarusian and Macedonian letters
+// of the standard, e.g. "ґ" becomes "g`" and "ї" becomes "yi".
+// It is reversible for Russian, "Щёлковский проезд" becomes "Shhyolkovskij proezd"
+// and back, except that two signs in a row like "ьь" are read back as other signs,
+// and an uppercase Ъ or Ь comes back lowercase at the start of a word or at the end
+// of a two-letter word, as its Latin form has no case. The other letters are read
+// back as Russian ones, e.g. "g`" as "гь".
+var GOST779 = &Scheme{
+	name:  "GOST 7.79-2000 B",
+	table: gost779All,
+	special: func(_, r, next rune) (string, bool) {
+		if r != 'ц' {
+			return "", false
+		}
+		// "c" before i, e, y and j, "cz" elsewhere.
+		if t := gost779All[next]; t != "" && strings.ContainsAny(t[:1], "iejy") {
+			return "c", true
+		}
+		return "cz", true
+	},
+	reverse: newReverse(gost779, reversePair{latin: "c", cyrillic: 'ц'}),
+}
+
+// ICAO9303 is the scheme of ICAO Doc 9303 for machine readable passports,
+// used in Russian international passports since 2013. It is not reversible.
+var ICAO9303 = &Scheme{
+	name:  "ICAO 9303",
+	table: icao9303,
+}
+
+// BGNPCGN is the BGN/PCGN 1947 romanization of Russian used for geographic names.
+// It is not reversible.
+var BGNPCGN = &Scheme{
+	name:  "BGN/PCGN",
+	table: bgnPCGN,
+	special: func(prev, r, _ rune) (string, bool) {
+		if (r == 'е' || r == 'ё') && (isWordStart(prev) || strings.ContainsRune("аеёиоуыэюяйъь", prev)) {
+			return "y" + bgnPCGN[r], true
+		}
+		return "", false
+	},
+}
+
+// Ukrainian is the official romanization of Ukrainian adopted by the Cabinet
+// of Ministers of Ukraine in 2010. It is not reversible.
+var Ukrainian = &Scheme{
+	name:  "Ukrainian national",
+	table: ukrainian,
+	special: func(prev, r, next rune) (string, bool) {
+		switch {
+		case isWordStart(prev) && ukrainianInitial[r] != "":
+			return ukrainianInitial[r], true
+		case r == 'г' && prev == 'з':
+			return "gh", true
+		case isApostrophe(r) && unicode.IsLetter(prev) && unicode.IsLetter(next):
+			return "", true
+		}
+		return "", false
+	},
+}
+
+var gost779 = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "j", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "x", 'ц': "cz", 'ч': "ch", 'ш': "sh", 'щ': "shh", 'ъ': "``",
+	'ы': "y'", 'ь': "`", 'э': "e'", 'ю': "yu", 'я': "ya",
+}
+
+// gost779Other holds the letters of the other languages, which are left out
+// of the reverse table as they clash with the Russian ones, e.g. "l`" with "ль".
+var gost779Other = map[rune]string{
+	'ґ': "g`", 'є': "ye", 'і': "i", 'ї': "yi", 'ў': "u`", 'ѓ': "g`", 'ѕ': "z`",
+	'ј': "j", 'љ': "l`", 'њ': "n`", 'ќ': "k`", 'џ': "dh",
+}
+
+// gost779All is the table of GOST779 for all the supported languages.
+var gost779All = func() map[rune]string {
+	m := make(map[rune]string, len(gost779)+len(gost779Other))
+	for _, t := range []map[rune]string{gost779, gost779Other} {
+		for r, latin := range t {
+			m[r] = latin
+		}
+	}
+	return m
+}()
+
+var icao9303 = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "ie",
+	'ы': "y", 'ь': "", 'э': "e", 'ю': "iu", 'я': "ia",
+	'ґ': "g", 'є': "ie", 'і': "i", 'ї': "i", 'ў': "u",
+}
+
+var bgnPCGN = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "ë",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "”",
+	'ы': "y", 'ь': "’", 'э': "e", 'ю': "yu", 'я': "ya",
+}
+
+var ukrainian = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "h", 'ґ': "g", 'д': "d", 'е': "e",
+	'є': "ie", 'ж': "zh", 'з': "z", 'и': "y", 'і': "i", 'ї': "i", 'й': "i",
+	'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r",
+	'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch",
+	'ш': "sh", 'щ': "shch", 'ь': "", 'ю': "iu", 'я': "ia",
+}
+
+// ukrainianInitial holds the forms used at the start of a word.
+var ukrainianInitial = map[rune]string{
+	'є': "ye", 'ї': "yi", 'й': "y", 'ю': "yu", 'я': "ya",
+}
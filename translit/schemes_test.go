@@ -0,0 +1,104 @@
+package translit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		scheme *Scheme
+		cases  map[string]string
+	}{
+		{
+			scheme: GOST779,
+			cases: map[string]string{
+				"абвгдеёжзийклмнопрстуфхцчшщъыьэюя": "abvgdeyozhzijklmnoprstufxczchshshh``y'`e'yuya",
+				"Щёлковский объезд":                 "Shhyolkovskij ob``ezd",
+				"Цирк":                              "Cirk",
+				"Лицо":                              "Liczo",
+				"Цюрих":                             "Cyurix",
+				"Мышь":                              "My'sh`",
+				"Эхо":                               "E'xo",
+				"ЦАРЬ":                              "CZAR`",
+			},
+		},
+		{
+			scheme: ICAO9303,
+			cases: map[string]string{
+				"абвгдеёжзийклмнопрстуфхцчшщъыьэюя": "abvgdeezhziiklmnoprstufkhtschshshchieyeiuia",
+				"Горбачёв Михаил":                   "Gorbachev Mikhail",
+				"ЩЕРБАКОВ ЮРИЙ":                     "SHCHERBAKOV IURII",
+				"Подъячев":                          "Podieiachev",
+				"Наталья":                           "Natalia",
+			},
+		},
+		{
+			scheme: BGNPCGN,
+			cases: map[string]string{
+				"абвгдеёжзийклмнопрстуфхцчшщъыьэюя": "abvgdeyëzhziyklmnoprstufkhtschshshch”y’eyuya",
+				"Ежов":     "Yezhov",
+				"Ёлкин":    "Yëlkin",
+				"Хрущёв":   "Khrushchëv",
+				"Юрьев":    "Yur’yev",
+				"Подъезд":  "Pod”yezd",
+				"Майя":     "Mayya",
+				"Объём":    "Ob”yëm",
+				"Соловьёв": "Solov’yëv",
+			},
+		},
+		{
+			scheme: Ukrainian,
+			cases: map[string]string{
+				"Алушта":      "Alushta",
+				"Андрій":      "Andrii",
+				"Борщагівка":  "Borshchahivka",
+				"Вінниця":     "Vinnytsia",
+				"Гадяч":       "Hadiach",
+				"Згорани":     "Zghorany",
+				"Розгон":      "Rozghon",
+				"Ґалаґан":     "Galagan",
+				"Горгани":     "Horhany",
+				"Дон":         "Don",
+				"Рівне":       "Rivne",
+				"Єнакієве":    "Yenakiieve",
+				"Гаєвич":      "Haievych",
+				"Короп'є":     "Koropie",
+				"Житомир":     "Zhytomyr",
+				"Закарпаття":  "Zakarpattia",
+				"Медвин":      "Medvyn",
+				"Іванків":     "Ivankiv",
+				"Їжакевич":    "Yizhakevych",
+				"Кадиївка":    "Kadyivka",
+				"Йосипівка":   "Yosypivka",
+				"Стрий":       "Stryi",
+				"Олексій":     "Oleksii",
+				"Київ":        "Kyiv",
+				"Харків":      "Kharkiv",
+				"Біла Церква": "Bila Tserkva",
+				"Шостка":      "Shostka",
+				"Гоща":        "Hoshcha",
+				"Русь":        "Rus",
+				"Юрій":        "Yurii",
+				"Корюківка":   "Koriukivka",
+				"Яготин":      "Yahotyn",
+				"Костянтин":   "Kostiantyn",
+				"Знам’янка":   "Znamianka",
+				"Феодосія":    "Feodosiia",
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.scheme.String(), func(t *testing.T) {
+			t.Parallel()
+
+			for cyrillic, latin := range tt.cases {
+				require.Equal(t, latin, To(cyrillic, tt.scheme), cyrillic)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+// Package translit converts text between the Cyrillic and Latin scripts
+// following the official transliteration schemes.
+package translit
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrIrreversible is returned by From for schemes that lose information.
+var ErrIrreversible = errors.New("translit: scheme is not reversible")
+
+// Scheme describes a transliteration scheme.
+type Scheme struct {
+	name string
+	// table maps lowercase Cyrillic letters to Latin.
+	table map[rune]string
+	// special overrides table depending on the lowercase neighbours of the letter,
+	// which are zero at the edges of the text.
+	special func(prev, r, next rune) (string, bool)
+	// reverse lists Latin sequences and their Cyrillic letters, longest first.
+	// It is nil for irreversible schemes.
+	reverse []reversePair
+}
+
+// reversePair is a Latin sequence and the lowercase Cyrillic letter it stands for.
+type reversePair struct {
+	latin    string
+	cyrillic rune
+}
+
+// String returns the name of the scheme.
+func (s *Scheme) String() string {
+	return s.name
+}
+
+// Reversible defines if the scheme can be used with From.
+func (s *Scheme) Reversible() bool {
+	return s.reverse != nil
+}
+
+// To transliterates the Cyrillic letters of s to Latin with the scheme.
+// Other characters are kept. An uppercase letter becomes uppercase
+// if the neighbouring letter is uppercase too, e.g. "ЩИТ" gives "SHHIT",
+// and capitalised otherwise, e.g. "Щит" gives "Shhit".
+func To(s string, scheme *Scheme) string {
+	runes := []rune(s)
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for i, r := range runes {
+		lower := unicode.ToLower(r)
+		t, ok := scheme.translit(neighbour(runes, i-1), lower, neighbour(runes, i+1))
+		if !ok {
+			sb.WriteRune(r)
+			continue
+		}
+		if r != lower {
+			allCaps := (i > 0 && unicode.IsUpper(runes[i-1])) ||
+				(i+1 < len(runes) && unicode.IsUpper(runes[i+1]))
+			t = upper(t, allCaps)
+		}
+		sb.WriteString(t)
+	}
+	return sb.String()
+}
+
+// From transliterates the Latin text back to Cyrillic with a reversible scheme.
+// Sequences not produced by the scheme are kept. The case of a letter
+// follows the case of the first character of its Latin sequence, and the
+// signs like "`" are uppercase between uppercase letters or after two
+// of them at the end of a word, e.g. "MY'SH`" gives "МЫШЬ".
+func From(s string, scheme *Scheme) (string, error) {
+	if !scheme.Reversible() {
+		return "", fmt.Errorf("%w: %s", ErrIrreversible, scheme.name)
+	}
+
+	var (
+		sb strings.Builder
+		// caps holds the case of the last two letters of the word.
+		caps [2]bool
+	)
+	sb.Grow(len(s) * 2)
+	for len(s) > 0 {
+		p, ok := scheme.match(s)
+		if !ok {
+			r, size := utf8.DecodeRuneInString(s)
+			if unicode.IsLetter(r) {
+				caps = [2]bool{caps[1], unicode.IsUpper(r)}
+			} else {
+				caps = [2]bool{}
+			}
+			sb.WriteString(s[:size])
+			s = s[size:]
+			continue
+		}
+		first, _ := utf8.DecodeRuneInString(s)
+		s = s[len(p.latin):]
+
+		isUpper := unicode.IsUpper(first)
+		if !unicode.IsLetter(first) {
+			next, _ := utf8.DecodeRuneInString(s)
+			if unicode.IsLetter(next) {
+				isUpper = caps[1] && unicode.IsUpper(next)
+			} else {
+				isUpper = caps[0] && caps[1]
+			}
+		}
+		if isUpper {
+			sb.WriteRune(unicode.ToUpper(p.cyrillic))
+		} else {
+			sb.WriteRune(p.cyrillic)
+		}
+		caps = [2]bool{caps[1], isUpper}
+	}
+	return sb.String(), nil
+}
+
+// match returns the longest Latin sequence of the scheme at the start of text.
+func (s *Scheme) match(text string) (reversePair, bool) {
+	for _, p := range s.reverse {
+		if len(text) >= len(p.latin) && strings.EqualFold(text[:len(p.latin)], p.latin) {
+			return p, true
+		}
+	}
+	return reversePair{}, false
+}
+
+// translit returns the Latin form of the lowercase letter r.
+func (s *Scheme) translit(prev, r, next rune) (string, bool) {
+	if s.special != nil {
+		if t, ok := s.special(prev, r, next); ok {
+			return t, true
+		}
+	}
+	t, ok := s.table[r]
+	return t, ok
+}
+
+// newReverse builds the reverse table of a scheme from its table and extra pairs.
+func newReverse(table map[rune]string, extra ...reversePair) []reversePair {
+	pairs := append([]reversePair(nil), extra...)
+	for r, latin := range table {
+		pairs = append(pairs, reversePair{latin: latin, cyrillic: r})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if len(pairs[i].latin) != len(pairs[j].latin) {
+			return len(pairs[i].latin) > len(pairs[j].latin)
+		}
+		return pairs[i].latin < pairs[j].latin
+	})
+	return pairs
+}
+
+// neighbour returns the lowercase rune at i or zero if i is out of range.
+func neighbour(runes []rune, i int) rune {
+	if i < 0 || i >= len(runes) {
+		return 0
+	}
+	return unicode.ToLower(runes[i])
+}
+
+// upper uppercases the whole transliteration or only its first letter.
+func upper(t string, all bool) string {
+	if all {
+		return strings.ToUpper(t)
+	}
+	r, size := utf8.DecodeRuneInString(t)
+	return string(unicode.ToUpper(r)) + t[size:]
+}
+
+// isWordStart defines if a letter following prev starts a word.
+func isWordStart(prev rune) bool {
+	return prev == 0 || !unicode.IsLetter(prev) && !isApostrophe(prev)
+}
+
+// isApostrophe defines if the rune is one of the apostrophes used in Cyrillic texts.
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '’' || r == 'ʼ'
+}
@@ -0,0 +1,137 @@
+package translit
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTo(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		s      string
+		scheme *Scheme
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{name: "empty", args: args{s: "", scheme: GOST779}, want: ""},
+		{name: "other characters kept", args: args{s: "Привет, world! 42", scheme: ICAO9303}, want: "Privet, world! 42"},
+		{name: "capitalised", args: args{s: "Щит", scheme: GOST779}, want: "Shhit"},
+		{name: "all caps", args: args{s: "ЩИТ", scheme: GOST779}, want: "SHHIT"},
+		{name: "single capital", args: args{s: "Ж", scheme: ICAO9303}, want: "Zh"},
+		{name: "unknown letters kept", args: args{s: "ӂанок", scheme: GOST779}, want: "ӂanok"},
+		{name: "gost other languages", args: args{s: "Їжак і ґанок, цієї Џ", scheme: GOST779}, want: "Yizhak i g`anok, ciyeyi Dh"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, To(tt.args.s, tt.args.scheme))
+		})
+	}
+}
+
+func TestFrom(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		s      string
+		scheme *Scheme
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{name: "gost", args: args{s: "Shhyolkovskij ob``ezd", scheme: GOST779}, want: "Щёлковский объезд"},
+		{name: "gost c and cz", args: args{s: "Cirk liczo czar`", scheme: GOST779}, want: "Цирк лицо царь"},
+		{name: "gost all caps", args: args{s: "SHHIT", scheme: GOST779}, want: "ЩИТ"},
+		{name: "gost signs", args: args{s: "My'sh` E'xo", scheme: GOST779}, want: "Мышь Эхо"},
+		{name: "gost signs in a row", args: args{s: "``", scheme: GOST779}, want: "ъ"},
+		{name: "gost sign without case", args: args{s: "``EXO D` ` DOM", scheme: GOST779}, want: "ъЕХО Дь ь ДОМ"},
+		{name: "unknown kept", args: args{s: "q w 42!", scheme: GOST779}, want: "q w 42!"},
+		{name: "icao", args: args{s: "Mikhail", scheme: ICAO9303}, wantErr: ErrIrreversible},
+		{name: "bgn", args: args{s: "Yezhov", scheme: BGNPCGN}, wantErr: ErrIrreversible},
+		{name: "ukrainian", args: args{s: "Kyiv", scheme: Ukrainian}, wantErr: ErrIrreversible},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := From(tt.args.s, tt.args.scheme)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGOST779_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	texts := []string{
+		"абвгдеёжзийклмнопрстуфхцчшщъыьэюя",
+		"АБВГДЕЁЖЗИЙКЛМНОПРСТУФХЦЧШЩЪЫЬЭЮЯ",
+		"Съешь же ещё этих мягких французских булок, да выпей чаю.",
+		"Цыц, цапля! Шхуна сходила в Цхинвали, подъезд №5.",
+	}
+	for _, text := range texts {
+		got, err := From(To(text, GOST779), GOST779)
+		require.NoError(t, err)
+		require.Equal(t, text, got)
+	}
+}
+
+func TestGOST779_RoundTripRandom(t *testing.T) {
+	t.Parallel()
+
+	alphabet := []rune("абвгдеёжзийклмнопрстуфхцчшщъыьэюя")
+	isSign := func(r rune) bool { return r == 'ъ' || r == 'ь' }
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	for range 1000 {
+		words := make([]string, 1+rnd.IntN(5))
+		for i := range words {
+			word := make([]rune, 1+rnd.IntN(8))
+			for j := range word {
+				// The supported subset: no sign starts a word or follows another one.
+				for {
+					word[j] = alphabet[rnd.IntN(len(alphabet))]
+					if !isSign(word[j]) || j > 0 && !isSign(word[j-1]) {
+						break
+					}
+				}
+			}
+
+			w := string(word)
+			switch rnd.IntN(3) {
+			case 1:
+				r, size := utf8.DecodeRuneInString(w)
+				w = strings.ToUpper(string(r)) + w[size:]
+			case 2:
+				if len(word) != 2 {
+					w = strings.ToUpper(w)
+				}
+			}
+			words[i] = w
+		}
+
+		text := strings.Join(words, " ")
+		got, err := From(To(text, GOST779), GOST779)
+		require.NoError(t, err)
+		require.Equal(t, text, got)
+	}
+}
@@ -0,0 +1,199 @@
+package stringo
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/sitnikovik/stringo/internal/grapheme"
+)
+
+// Width returns the number of terminal cells needed to display s.
+// Every grapheme cluster takes the width of its first rune: two cells
+// for East Asian wide and fullwidth characters and emoji, none for
+// control characters and lone combining marks, one for the rest.
+// Flags and emoji with the emoji presentation selector take two cells.
+func Width(s string) int {
+	w := 0
+	for g := range Graphemes(s) {
+		w += graphemeWidth(g)
+	}
+	return w
+}
+
+// Truncate shortens s to fit in width cells ending it with the ellipsis,
+// e.g. "…". The ellipsis is dropped if it does not fit by itself.
+// Grapheme clusters are never split and s is returned as is if it fits.
+func Truncate(s string, width int, ellipsis string) string {
+	if Width(s) <= width {
+		return s
+	}
+	if Width(ellipsis) > width {
+		ellipsis = ""
+	}
+	head, _ := cutWidth(s, width-Width(ellipsis))
+	return head + ellipsis
+}
+
+// PadLeft pads s with spaces on the left up to width cells.
+func PadLeft(s string, width int) string {
+	return pad(s, width-Width(s), 0)
+}
+
+// PadRight pads s with spaces on the right up to width cells.
+func PadRight(s string, width int) string {
+	return pad(s, 0, width-Width(s))
+}
+
+// Center pads s with spaces on both sides up to width cells.
+// The extra space goes to the right when the padding is odd.
+func Center(s string, width int) string {
+	n := width - Width(s)
+	return pad(s, n/2, n-n/2)
+}
+
+// WidthANSI is like Width but ignores ANSI escape sequences, e.g. colors.
+func WidthANSI(s string) int {
+	w := 0
+	for len(s) > 0 {
+		if n := ansiLen(s); n > 0 {
+			s = s[n:]
+			continue
+		}
+		text := s[:nextANSI(s)]
+		w += Width(text)
+		s = s[len(text):]
+	}
+	return w
+}
+
+// TruncateANSI is like Truncate but ignores ANSI escape sequences.
+// The escape sequences after the cut are kept, so the colors are reset as in s.
+func TruncateANSI(s string, width int, ellipsis string) string {
+	if WidthANSI(s) <= width {
+		return s
+	}
+	if WidthANSI(ellipsis) > width {
+		ellipsis = ""
+	}
+	width -= WidthANSI(ellipsis)
+
+	var sb strings.Builder
+	cut := false
+	for len(s) > 0 {
+		if n := ansiLen(s); n > 0 {
+			sb.WriteString(s[:n])
+			s = s[n:]
+			continue
+		}
+		text := s[:nextANSI(s)]
+		s = s[len(text):]
+		if cut {
+			continue
+		}
+		head, fits := cutWidth(text, width)
+		sb.WriteString(head)
+		width -= Width(head)
+		if !fits {
+			sb.WriteString(ellipsis)
+			cut = true
+		}
+	}
+	return sb.String()
+}
+
+// PadLeftANSI is like PadLeft but ignores ANSI escape sequences.
+func PadLeftANSI(s string, width int) string {
+	return pad(s, width-WidthANSI(s), 0)
+}
+
+// PadRightANSI is like PadRight but ignores ANSI escape sequences.
+func PadRightANSI(s string, width int) string {
+	return pad(s, 0, width-WidthANSI(s))
+}
+
+// CenterANSI is like Center but ignores ANSI escape sequences.
+func CenterANSI(s string, width int) string {
+	n := width - WidthANSI(s)
+	return pad(s, n/2, n-n/2)
+}
+
+// graphemeWidth returns the display width of a grapheme cluster.
+func graphemeWidth(g string) int {
+	r, _ := utf8.DecodeRuneInString(g)
+	switch {
+	case r >= 0x1f1e6 && r <= 0x1f1ff:
+		return 2
+	case unicode.Is(wide, r):
+		return 2
+	case strings.ContainsRune(g, '\ufe0f') && grapheme.IsExtendedPictographic(r):
+		return 2
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Zl, unicode.Zp, unicode.Mn, unicode.Me):
+		return 0
+	}
+	return 1
+}
+
+// cutWidth returns the longest prefix of s fitting in width cells
+// and reports if it is the whole s.
+func cutWidth(s string, width int) (string, bool) {
+	end, w := 0, 0
+	for g := range Graphemes(s) {
+		w += graphemeWidth(g)
+		if w > width {
+			return s[:end], false
+		}
+		end += len(g)
+	}
+	return s, true
+}
+
+// pad surrounds s with the given number of spaces, negative ones meaning none.
+func pad(s string, left, right int) string {
+	return strings.Repeat(" ", max(left, 0)) + s + strings.Repeat(" ", max(right, 0))
+}
+
+// ansiLen returns the length of the ANSI escape sequence at the start of s,
+// or zero if there is none. CSI sequences like colors, OSC sequences like
+// hyperlinks and two-character escapes are recognised.
+func ansiLen(s string) int {
+	if len(s) < 2 || s[0] != '\x1b' {
+		return 0
+	}
+	switch s[1] {
+	case '[':
+		for i := 2; i < len(s); i++ {
+			if s[i] >= 0x40 && s[i] <= 0x7e {
+				return i + 1
+			}
+			if s[i] < 0x20 || s[i] > 0x3f {
+				return 0
+			}
+		}
+	case ']':
+		for i := 2; i < len(s); i++ {
+			if s[i] == '\a' {
+				return i + 1
+			}
+			if s[i] == '\x1b' && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2
+			}
+		}
+	default:
+		if s[1] >= 0x40 && s[1] <= 0x5f {
+			return 2
+		}
+	}
+	return 0
+}
+
+// nextANSI returns the position of the next ANSI escape sequence in s
+// after its first byte, or the length of s.
+func nextANSI(s string) int {
+	for i := 1; i < len(s); i++ {
+		if s[i] == '\x1b' && ansiLen(s[i:]) > 0 {
+			return i
+		}
+	}
+	return len(s)
+}
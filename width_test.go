@@ -0,0 +1,138 @@
+package stringo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWidth(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{s: "", want: 0},
+		{s: "hello", want: 5},
+		{s: "привет", want: 6},
+		{s: "你好", want: 4},
+		{s: "ｈｉ", want: 4},
+		{s: "한국어", want: 6},
+		{s: "e\u0301", want: 1},
+		{s: "👍", want: 2},
+		{s: "👍🏽", want: 2},
+		{s: "👨\u200d👩\u200d👧", want: 2},
+		{s: "🇺🇸", want: 2},
+		{s: "❤", want: 1},
+		{s: "❤\ufe0f", want: 2},
+		{s: "a\tb\n", want: 2},
+		{s: "a\u200bb", want: 2},
+		{s: "\u0378", want: 1},
+		{s: "\U00050000", want: 1},
+		{s: "\U0010ffff", want: 1},
+		{s: "\U0002fffd", want: 2},
+		{s: "\u9fff", want: 2},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, Width(tt.s))
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		s        string
+		width    int
+		ellipsis string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{name: "fits", args: args{s: "hello", width: 5, ellipsis: "…"}, want: "hello"},
+		{name: "ascii", args: args{s: "hello world", width: 8, ellipsis: "…"}, want: "hello w…"},
+		{name: "no ellipsis", args: args{s: "hello world", width: 5}, want: "hello"},
+		{name: "wide characters", args: args{s: "你好世界", width: 5, ellipsis: "…"}, want: "你好…"},
+		{name: "wide character does not fit", args: args{s: "你好世界", width: 4, ellipsis: "…"}, want: "你…"},
+		{name: "combining marks kept", args: args{s: "cafe\u0301 noir", width: 5, ellipsis: "…"}, want: "cafe\u0301…"},
+		{name: "emoji not split", args: args{s: "ok 👨\u200d👩\u200d👧 fine", width: 5, ellipsis: "."}, want: "ok ."},
+		{name: "ellipsis too wide", args: args{s: "hello", width: 2, ellipsis: "..."}, want: "he"},
+		{name: "zero width", args: args{s: "hello", width: 0, ellipsis: "…"}, want: ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, Truncate(tt.args.s, tt.args.width, tt.args.ellipsis))
+		})
+	}
+}
+
+func TestPad(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "PadLeft", got: PadLeft("ab", 5), want: "   ab"},
+		{name: "PadLeft wide", got: PadLeft("你好", 6), want: "  你好"},
+		{name: "PadLeft too long", got: PadLeft("hello", 3), want: "hello"},
+		{name: "PadRight", got: PadRight("ab", 5), want: "ab   "},
+		{name: "PadRight emoji", got: PadRight("👍", 4), want: "👍  "},
+		{name: "Center", got: Center("ab", 6), want: "  ab  "},
+		{name: "Center odd", got: Center("ab", 5), want: " ab  "},
+		{name: "Center combining", got: Center("e\u0301", 3), want: " e\u0301 "},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, tt.got)
+		})
+	}
+}
+
+func TestANSI(t *testing.T) {
+	t.Parallel()
+
+	const (
+		red   = "\x1b[31m"
+		reset = "\x1b[0m"
+		link  = "\x1b]8;;https://example.com\x1b\\"
+	)
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{name: "WidthANSI", got: WidthANSI(red + "hello" + reset), want: 5},
+		{name: "WidthANSI hyperlink", got: WidthANSI(link + "你好" + "\x1b]8;;\a"), want: 4},
+		{name: "WidthANSI bare escape", got: WidthANSI("\x1b"), want: 0},
+		{name: "TruncateANSI", got: TruncateANSI(red+"hello"+reset+" world", 4, "…"), want: red + "hel…" + reset},
+		{name: "TruncateANSI across sequences", got: TruncateANSI("ab"+red+"cd"+reset+"ef", 4, "…"), want: "ab" + red + "c…" + reset},
+		{name: "TruncateANSI fits", got: TruncateANSI(red+"hi"+reset, 2, "…"), want: red + "hi" + reset},
+		{name: "PadLeftANSI", got: PadLeftANSI(red+"ab"+reset, 4), want: "  " + red + "ab" + reset},
+		{name: "PadRightANSI", got: PadRightANSI(red+"ab"+reset, 4), want: red + "ab" + reset + "  "},
+		{name: "CenterANSI", got: CenterANSI(red+"ab"+reset, 4), want: " " + red + "ab" + reset + " "},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, tt.got)
+		})
+	}
+}
@@ -0,0 +1,311 @@
+package stringo
+
+import (
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/sitnikovik/stringo/internal/grapheme"
+)
+
+// WrapAlgorithm describes how Wrap chooses line breaks.
+type WrapAlgorithm int8
+
+const (
+	// WrapGreedy describes filling every line as much as possible, the default
+	WrapGreedy WrapAlgorithm = iota + 1
+	// WrapBalanced describes minimising the raggedness of the paragraph
+	// in the manner of Knuth and Plass: the sum of squared free space
+	// at the end of every line but the last
+	WrapBalanced
+)
+
+// LongWordPolicy describes what Wrap does with words longer than a line.
+type LongWordPolicy int8
+
+const (
+	// LongWordOverflow describes long words left on lines of their own, the default
+	LongWordOverflow LongWordPolicy = iota + 1
+	// LongWordBreak describes long words broken at grapheme cluster boundaries
+	LongWordBreak
+)
+
+// WrapOptions describes how Wrap formats paragraphs.
+type WrapOptions struct {
+	// Algorithm chooses line breaks, WrapGreedy by default.
+	Algorithm WrapAlgorithm
+	// Indent starts the first line of every paragraph.
+	Indent string
+	// HangingIndent starts the other lines of every paragraph.
+	HangingIndent string
+	// KeepPrefix preserves comment and quotation markers like "//", "#" and ">"
+	// found at the start of the lines, repeating them on every wrapped line.
+	KeepPrefix bool
+	// LongWords defines what to do with words longer than a line, LongWordOverflow by default.
+	LongWords LongWordPolicy
+	// Hyphenate splits a word into the parts it may be broken between,
+	// e.g. "wrap-ping". A hyphen is added at the end of the line when
+	// a word is broken. Nil means words are not hyphenated.
+	Hyphenate func(word string) []string
+}
+
+// wrapHyphenCost is the extra cost of a hyphenated line for WrapBalanced.
+const wrapHyphenCost = 50
+
+// Wrap reflows the paragraphs of s to lines of at most width terminal cells
+// as measured by Width. Paragraphs are separated by blank lines, the lines of
+// a paragraph are joined, and the white space between words is collapsed.
+// Zero or negative width puts every paragraph on a single line.
+func Wrap(s string, width int, opts WrapOptions) string {
+	if width <= 0 {
+		width = math.MaxInt32
+	}
+
+	var out []string
+	for _, p := range wrapParagraphs(s, opts.KeepPrefix) {
+		if len(p.words) == 0 {
+			out = append(out, strings.TrimRight(p.prefix, " \t"))
+			continue
+		}
+		first := p.prefix + opts.Indent
+		rest := p.prefix + opts.HangingIndent
+		out = append(out, wrapLines(p.words, first, rest, width, opts)...)
+	}
+	return strings.Join(out, "\n")
+}
+
+// wrapParagraph is a paragraph of words with the prefix of its lines.
+// A paragraph without words stands for a blank line.
+type wrapParagraph struct {
+	prefix string
+	words  []string
+}
+
+// wrapParagraphs splits s into paragraphs at blank lines and prefix changes.
+func wrapParagraphs(s string, keepPrefix bool) []wrapParagraph {
+	var (
+		paragraphs []wrapParagraph
+		open       bool
+	)
+	for _, line := range strings.Split(s, "\n") {
+		prefix := ""
+		if keepPrefix {
+			prefix = linePrefix(line)
+		}
+		words := strings.Fields(line[len(prefix):])
+
+		last := len(paragraphs) - 1
+		switch {
+		case len(words) == 0:
+			paragraphs = append(paragraphs, wrapParagraph{prefix: prefix})
+			open = false
+		case open && strings.TrimSpace(paragraphs[last].prefix) == strings.TrimSpace(prefix):
+			paragraphs[last].words = append(paragraphs[last].words, words...)
+		default:
+			paragraphs = append(paragraphs, wrapParagraph{prefix: prefix, words: words})
+			open = true
+		}
+	}
+	return paragraphs
+}
+
+// linePrefix returns the leading white space and comment or quotation markers
+// of the line with a single space after them.
+func linePrefix(line string) string {
+	i := 0
+	for {
+		j := i
+		for j < len(line) && (line[j] == ' ' || line[j] == '\t') {
+			j++
+		}
+		switch {
+		case strings.HasPrefix(line[j:], "//"):
+			i = j + 2
+		case strings.HasPrefix(line[j:], "#"), strings.HasPrefix(line[j:], ">"):
+			i = j + 1
+		default:
+			if i == 0 {
+				return line[:j]
+			}
+			if i < len(line) && line[i] == ' ' {
+				i++
+			}
+			return line[:i]
+		}
+	}
+}
+
+// wrapPiece is an unbreakable part of the text.
+type wrapPiece struct {
+	text  string
+	width int
+	// space tells that the piece starts a word, so it follows a space on the line.
+	space bool
+	// hyphen tells that a hyphen is needed when a line breaks before the piece.
+	hyphen bool
+}
+
+// wrapLines breaks the words into lines starting with the given prefixes.
+func wrapLines(words []string, first, rest string, width int, opts WrapOptions) []string {
+	avail := [2]int{width - Width(first), width - Width(rest)}
+	pieces := wrapPieces(words, min(avail[0], avail[1]), opts)
+
+	var breaks []int
+	if opts.Algorithm == WrapBalanced {
+		breaks = balancedBreaks(pieces, avail)
+	} else {
+		breaks = greedyBreaks(pieces, avail)
+	}
+
+	lines := make([]string, 0, len(breaks))
+	start := 0
+	for n, end := range breaks {
+		prefix := rest
+		if n == 0 {
+			prefix = first
+		}
+		lines = append(lines, prefix+lineText(pieces, start, end))
+		start = end
+	}
+	return lines
+}
+
+// wrapPieces splits the words into pieces applying hyphenation and the long word policy.
+func wrapPieces(words []string, avail int, opts WrapOptions) []wrapPiece {
+	pieces := make([]wrapPiece, 0, len(words))
+	for _, word := range words {
+		parts := []string{word}
+		if opts.Hyphenate != nil {
+			if hp := opts.Hyphenate(word); strings.Join(hp, "") == word && len(hp) > 1 {
+				parts = hp
+			}
+		}
+		for i, part := range parts {
+			p := wrapPiece{text: part, width: Width(part), space: i == 0, hyphen: i > 0}
+			if opts.LongWords != LongWordBreak || p.width <= avail {
+				pieces = append(pieces, p)
+				continue
+			}
+			for j, chunk := range breakWidth(part, max(avail, 1)) {
+				pieces = append(pieces, wrapPiece{
+					text:   chunk,
+					width:  Width(chunk),
+					space:  p.space && j == 0,
+					hyphen: p.hyphen && j == 0,
+				})
+			}
+		}
+	}
+	return pieces
+}
+
+// breakWidth splits s into chunks of at most width cells at grapheme boundaries.
+func breakWidth(s string, width int) []string {
+	var chunks []string
+	for s != "" {
+		chunk, _ := cutWidth(s, width)
+		if chunk == "" {
+			chunk = s[:grapheme.FirstLen(s)]
+		}
+		chunks = append(chunks, chunk)
+		s = s[len(chunk):]
+	}
+	return chunks
+}
+
+// lineWidth returns the width of the line of pieces[i:j].
+func lineWidth(pieces []wrapPiece, i, j int) int {
+	w := 0
+	for k := i; k < j; k++ {
+		w += pieces[k].width
+		if k > i && pieces[k].space {
+			w++
+		}
+	}
+	if j < len(pieces) && pieces[j].hyphen {
+		w++
+	}
+	return w
+}
+
+// lineText renders the line of pieces[i:j].
+func lineText(pieces []wrapPiece, i, j int) string {
+	var sb strings.Builder
+	for k := i; k < j; k++ {
+		if k > i && pieces[k].space {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(pieces[k].text)
+	}
+	if j < len(pieces) && pieces[j].hyphen {
+		sb.WriteByte('-')
+	}
+	return sb.String()
+}
+
+// greedyBreaks returns the ends of the lines filling every line as much as possible.
+// The first line may have a different width from the others.
+func greedyBreaks(pieces []wrapPiece, avail [2]int) []int {
+	var breaks []int
+	for i := 0; i < len(pieces); {
+		w := avail[min(len(breaks), 1)]
+		j := i + 1
+		for j < len(pieces) && lineWidth(pieces, i, j+1) <= w {
+			j++
+		}
+		breaks = append(breaks, j)
+		i = j
+	}
+	return breaks
+}
+
+// balancedBreaks returns the ends of the lines minimising the sum of squared
+// free space of all lines but the last. Lines of a single overflowing piece
+// are allowed at a high cost.
+func balancedBreaks(pieces []wrapPiece, avail [2]int) []int {
+	n := len(pieces)
+	cost := make([]int, n+1)
+	from := make([]int, n+1)
+	for j := 1; j <= n; j++ {
+		cost[j] = math.MaxInt
+	}
+
+	for i := 0; i < n; i++ {
+		if cost[i] == math.MaxInt {
+			continue
+		}
+		w := avail[0]
+		if i > 0 {
+			w = avail[1]
+		}
+		for j := i + 1; j <= n; j++ {
+			lw := lineWidth(pieces, i, j)
+			if lw > w && j > i+1 {
+				break
+			}
+
+			var c int
+			switch {
+			case lw > w:
+				c = (lw - w) * (lw - w) * 100
+			case j == n:
+				c = 0
+			default:
+				c = (w - lw) * (w - lw)
+			}
+			if j < n && pieces[j].hyphen {
+				c += wrapHyphenCost
+			}
+			if cost[i]+c < cost[j] {
+				cost[j], from[j] = cost[i]+c, i
+			}
+		}
+	}
+
+	var breaks []int
+	for j := n; j > 0; j = from[j] {
+		breaks = append(breaks, j)
+	}
+	slices.Reverse(breaks)
+	return breaks
+}
@@ -0,0 +1,120 @@
+package stringo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrap(t *testing.T) {
+	t.Parallel()
+
+	syllables := func(word string) []string {
+		if word == "hyphenation" {
+			return []string{"hy", "phen", "ation"}
+		}
+		return nil
+	}
+	type args struct {
+		s     string
+		width int
+		opts  WrapOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "empty",
+			args: args{s: "", width: 10},
+			want: "",
+		},
+		{
+			name: "greedy",
+			args: args{s: "the quick brown fox jumps over the lazy dog", width: 10},
+			want: "the quick\nbrown fox\njumps over\nthe lazy\ndog",
+		},
+		{
+			name: "reflow and collapse spaces",
+			args: args{s: "the  quick\nbrown   fox\n\n\njumps", width: 20},
+			want: "the quick brown fox\n\n\njumps",
+		},
+		{
+			name: "no width",
+			args: args{s: "a b\nc", width: 0},
+			want: "a b c",
+		},
+		{
+			name: "greedy is ragged",
+			args: args{s: "aaa bb cc ddddd", width: 6},
+			want: "aaa bb\ncc\nddddd",
+		},
+		{
+			name: "balanced",
+			args: args{s: "aaa bb cc ddddd", width: 6, opts: WrapOptions{Algorithm: WrapBalanced}},
+			want: "aaa\nbb cc\nddddd",
+		},
+		{
+			name: "hanging indent",
+			args: args{s: "-v, --verbose  print every step of the build", width: 24, opts: WrapOptions{HangingIndent: "    "}},
+			want: "-v, --verbose print\n    every step of the\n    build",
+		},
+		{
+			name: "indent",
+			args: args{s: "one two three four", width: 10, opts: WrapOptions{Indent: "  "}},
+			want: "  one two\nthree four",
+		},
+		{
+			name: "comment prefix",
+			args: args{s: "// Wrap reflows the paragraphs of s\n// to lines of at most width cells.\n//\n// Zero width disables it.", width: 24, opts: WrapOptions{KeepPrefix: true}},
+			want: "// Wrap reflows the\n// paragraphs of s to\n// lines of at most\n// width cells.\n//\n// Zero width disables\n// it.",
+		},
+		{
+			name: "nested quote and indented hash",
+			args: args{s: "> > quoted text here\n    # shell comment text", width: 14, opts: WrapOptions{KeepPrefix: true}},
+			want: "> > quoted\n> > text here\n    # shell\n    # comment\n    # text",
+		},
+		{
+			name: "long word overflows",
+			args: args{s: "a supercalifragilistic word", width: 8},
+			want: "a\nsupercalifragilistic\nword",
+		},
+		{
+			name: "long word broken",
+			args: args{s: "a supercalifragilistic word", width: 8, opts: WrapOptions{LongWords: LongWordBreak}},
+			want: "a\nsupercal\nifragili\nstic\nword",
+		},
+		{
+			name: "long wide word broken",
+			args: args{s: "你好世界你好", width: 5, opts: WrapOptions{LongWords: LongWordBreak}},
+			want: "你好\n世界\n你好",
+		},
+		{
+			name: "hyphenation",
+			args: args{s: "wrap with hyphenation", width: 17, opts: WrapOptions{Hyphenate: syllables}},
+			want: "wrap with hyphen-\nation",
+		},
+		{
+			name: "display width",
+			args: args{s: "你好 世界 你好", width: 9},
+			want: "你好 世界\n你好",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Wrap(tt.args.s, tt.args.width, tt.args.opts)
+
+			require.Equal(t, tt.want, got)
+			if tt.args.opts.LongWords == LongWordBreak {
+				for _, line := range strings.Split(got, "\n") {
+					require.LessOrEqual(t, Width(line), tt.args.width)
+				}
+			}
+		})
+	}
+}